@@ -1,8 +1,8 @@
 module github.com/supermekabu/go_utils
 
-go 1.18
+go 1.24
 
 require (
-	github.com/google/uuid v1.3.0 // indirect
-	github.com/oklog/ulid v1.3.1 // indirect
+	github.com/google/uuid v1.3.0
+	github.com/oklog/ulid v1.3.1
 )
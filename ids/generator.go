@@ -0,0 +1,102 @@
+package ids
+
+import (
+	"crypto/rand"
+	"fmt"
+	"io"
+	"sync"
+
+	"github.com/google/uuid"
+)
+
+/*
+Generator makes random UUIDs from an entropy source
+the zero value reads from crypto/rand
+*/
+type Generator struct {
+	entropy io.Reader
+}
+
+/*
+Make a Generator reading from entropy
+nil entropy means crypto/rand, any other reader is guarded by a mutex
+so the Generator stays safe for concurrent use
+*/
+func NewGenerator(entropy io.Reader) *Generator {
+	if entropy == nil {
+		return &Generator{}
+	}
+	return &Generator{entropy: &lockedReader{r: entropy}}
+}
+
+type lockedReader struct {
+	mu sync.Mutex
+	r  io.Reader
+}
+
+func (l *lockedReader) Read(p []byte) (int, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.r.Read(p)
+}
+
+func (g *Generator) reader() io.Reader {
+	if g.entropy == nil {
+		return rand.Reader
+	}
+	return g.entropy
+}
+
+/*
+Generate non-sortable UUID version 4
+fails when the entropy source fails
+*/
+func (g *Generator) New() (UUID, error) {
+	obj, err := uuid.NewRandomFromReader(g.reader())
+	if err != nil {
+		return UUID{}, fmt.Errorf("UUID generate failed: %w", err)
+	}
+	return UUID(obj), nil
+}
+
+/*
+Generate non-sortable UUID version 4 as a string
+fails when the entropy source fails
+*/
+func (g *Generator) NewUUID() (string, error) {
+	obj, err := g.New()
+	if err != nil {
+		return "", err
+	}
+	return obj.String(), nil
+}
+
+/*
+Generate non-sortable UUID version 4
+panics when the entropy source fails
+*/
+func (g *Generator) MustNewUUID() string {
+	id, err := g.NewUUID()
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+var defaultGenerator = &Generator{}
+
+/*
+Generate non-sortable UUID version 4 from crypto/rand
+fails when crypto/rand fails
+*/
+func NewUUIDE() (string, error) {
+	return defaultGenerator.NewUUID()
+}
+
+/*
+Generate non-sortable UUID version 4 from crypto/rand
+panics when crypto/rand fails
+*/
+func MustNewUUID() string {
+	return defaultGenerator.MustNewUUID()
+}
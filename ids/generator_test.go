@@ -0,0 +1,124 @@
+package ids
+
+import (
+	"bytes"
+	"errors"
+	mathrand "math/rand"
+	"runtime"
+	"sync"
+	"testing"
+
+	"github.com/google/uuid"
+)
+
+type failingReader struct{}
+
+func (failingReader) Read([]byte) (int, error) {
+	return 0, errors.New("entropy exhausted")
+}
+
+func TestGenerator_NewUUID(t *testing.T) {
+	tests := []struct {
+		name    string
+		entropy []byte
+		want    string
+	}{
+		{name: "zeros", entropy: make([]byte, 16), want: "00000000-0000-4000-8000-000000000000"},
+		{name: "ones", entropy: bytes.Repeat([]byte{0xff}, 16), want: "ffffffff-ffff-4fff-bfff-ffffffffffff"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NewGenerator(bytes.NewReader(tt.entropy)).NewUUID()
+			if err != nil {
+				t.Fatalf("NewUUID() error = %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("NewUUID() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGenerator_Error(t *testing.T) {
+	g := NewGenerator(failingReader{})
+	if id, err := g.NewUUID(); err == nil {
+		t.Errorf("NewUUID() = %v, want error", id)
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Errorf("MustNewUUID() did not panic")
+		}
+	}()
+	g.MustNewUUID()
+}
+
+func TestNewUUIDE(t *testing.T) {
+	id, err := NewUUIDE()
+	if err != nil {
+		t.Fatalf("NewUUIDE() error = %v", err)
+	}
+	obj, err := uuid.Parse(id)
+	if err != nil {
+		t.Fatalf("uuid.Parse(%v) error = %v", id, err)
+	}
+	if obj.Version() != 4 || obj.Variant() != uuid.RFC4122 {
+		t.Errorf("NewUUIDE() version %v variant %v, want 4 RFC4122", obj.Version(), obj.Variant())
+	}
+}
+
+func TestNewUUID_NoCollision(t *testing.T) {
+	total := 2_000_000
+	if testing.Short() {
+		total = 50_000
+	}
+	workers := runtime.GOMAXPROCS(0)
+	per := total / workers
+
+	results := make([][]UUID, workers)
+	var wg sync.WaitGroup
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func(w int) {
+			defer wg.Done()
+			ids := make([]UUID, per)
+			for i := range ids {
+				obj, err := defaultGenerator.New()
+				if err != nil {
+					t.Errorf("New() error = %v", err)
+					return
+				}
+				ids[i] = obj
+			}
+			results[w] = ids
+		}(w)
+	}
+	wg.Wait()
+
+	seen := make(map[UUID]struct{}, per*workers)
+	for _, ids := range results {
+		for _, id := range ids {
+			if _, ok := seen[id]; ok {
+				t.Fatalf("duplicate uuid %v", id)
+			}
+			seen[id] = struct{}{}
+		}
+	}
+}
+
+func TestGenerator_ConcurrentInjected(t *testing.T) {
+	// math/rand sources are not safe for concurrent use, the Generator must serialize reads.
+	g := NewGenerator(mathrand.New(mathrand.NewSource(1)))
+	var wg sync.WaitGroup
+	for w := 0; w < 4; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < 1000; i++ {
+				g.MustNewUUID()
+			}
+		}()
+	}
+	wg.Wait()
+}
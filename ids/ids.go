@@ -5,22 +5,16 @@ import (
 	"github.com/google/uuid"
 	"github.com/oklog/ulid"
 	"io"
-	"log"
 	"math/rand"
 	"time"
 )
 
 /*
-	Generate non-sortable UUID version 4
-	seed is now unix nano
+	Generate non-sortable UUID version 4 from crypto/rand
+	panics when crypto/rand fails, same as MustNewUUID
 */
 func NewUUID() string {
-	t := time.Now()
-	obj, err := uuid.NewRandomFromReader(rand.New(rand.NewSource(t.UnixNano())))
-	if err != nil {
-		log.Fatalf("UUID generate failed: %v", err)
-	}
-	return obj.String()
+	return MustNewUUID()
 }
 
 /*
@@ -46,6 +40,7 @@ func objHash(obj []byte) uuid.UUID {
 /*
 	Generate sortable ULID
 	seed is pre-generated entropy
+	panics when the entropy fails or overflows, ULIDGenerator returns an error instead
 */
 func NewULID(entropy io.Reader) string {
 	t := time.Now()
@@ -55,6 +50,8 @@ func NewULID(entropy io.Reader) string {
 /*
 	Make entropy for ULID
 	seed is given unix nano
+
+	Deprecated: the reader is not safe for concurrent use, use ULIDGenerator instead.
 */
 func NewEntropy(t time.Time) io.Reader {
 	entropy := ulid.Monotonic(rand.New(rand.NewSource(t.UnixNano())), 0)
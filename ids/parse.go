@@ -0,0 +1,316 @@
+package ids
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/oklog/ulid"
+)
+
+/*
+How strictly ParseUUID and ParseULID read their input
+*/
+type ParseMode int
+
+const (
+	// canonical form only: lowercase hyphenated UUIDs and uppercase ULIDs
+	Strict ParseMode = iota
+	// any case, UUIDs also without hyphens, in braces or with a urn:uuid: prefix
+	Lenient
+)
+
+var (
+	ErrLength            = errors.New("invalid length")
+	ErrInvalidChar       = errors.New("invalid character")
+	ErrCase              = errors.New("non-canonical case")
+	ErrHyphen            = errors.New("misplaced or missing hyphen")
+	ErrBraces            = errors.New("unexpected braces")
+	ErrPrefix            = errors.New("unexpected urn:uuid: prefix")
+	ErrTimestampOverflow = errors.New("timestamp overflows 48 bits")
+)
+
+/*
+ParseError tells why an input is not a valid ID
+Err is one of the Err sentinels above, Offset is the byte offset in Input
+the problem was found at, or -1 when it concerns the whole input
+*/
+type ParseError struct {
+	Kind   Kind
+	Input  string
+	Offset int
+	Err    error
+}
+
+func (e *ParseError) Error() string {
+	if e.Offset < 0 {
+		return fmt.Sprintf("invalid %v %q: %v", e.Kind, e.Input, e.Err)
+	}
+	return fmt.Sprintf("invalid %v %q: %v at offset %d", e.Kind, e.Input, e.Err, e.Offset)
+}
+
+func (e *ParseError) Unwrap() error {
+	return e.Err
+}
+
+/*
+Kind of ID a string holds
+*/
+type Kind int
+
+const (
+	KindUnknown Kind = iota
+	KindUUID
+	KindULID
+)
+
+func (k Kind) String() string {
+	switch k {
+	case KindUUID:
+		return "UUID"
+	case KindULID:
+		return "ULID"
+	}
+	return "ID"
+}
+
+/*
+Tell whether s is a UUID, a ULID or neither, accepting the lenient forms
+*/
+func Detect(s string) Kind {
+	if _, err := ParseUUID(s, Lenient); err == nil {
+		return KindUUID
+	}
+	if _, err := ParseULID(s, Lenient); err == nil {
+		return KindULID
+	}
+	return KindUnknown
+}
+
+const urnPrefix = "urn:uuid:"
+
+/*
+Parse a UUID of any version
+strict mode takes only xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx in lowercase
+fails with a *ParseError
+*/
+func ParseUUID(s string, mode ParseMode) (UUID, error) {
+	fail := func(offset int, err error) (UUID, error) {
+		return UUID{}, &ParseError{Kind: KindUUID, Input: s, Offset: offset, Err: err}
+	}
+
+	body, start := s, 0
+	switch {
+	case len(s) >= len(urnPrefix) && strings.EqualFold(s[:len(urnPrefix)], urnPrefix):
+		if mode == Strict {
+			return fail(0, ErrPrefix)
+		}
+		body, start = s[len(urnPrefix):], len(urnPrefix)
+	case strings.HasPrefix(s, "{"):
+		if mode == Strict {
+			return fail(0, ErrBraces)
+		}
+		if !strings.HasSuffix(s, "}") {
+			return fail(len(s)-1, ErrBraces)
+		}
+		body, start = s[1:len(s)-1], 1
+	}
+
+	hyphens := true
+	switch len(body) {
+	case 36:
+	case 32:
+		if mode == Strict {
+			return fail(-1, ErrHyphen)
+		}
+		hyphens = false
+	default:
+		return fail(-1, ErrLength)
+	}
+
+	var u UUID
+	n := 0
+	for i := 0; i < len(body); i++ {
+		c := body[i]
+		if hyphens && (i == 8 || i == 13 || i == 18 || i == 23) {
+			if c != '-' {
+				return fail(start+i, ErrHyphen)
+			}
+			continue
+		}
+		var v byte
+		switch {
+		case '0' <= c && c <= '9':
+			v = c - '0'
+		case 'a' <= c && c <= 'f':
+			v = c - 'a' + 10
+		case 'A' <= c && c <= 'F':
+			if mode == Strict {
+				return fail(start+i, ErrCase)
+			}
+			v = c - 'A' + 10
+		case c == '-':
+			return fail(start+i, ErrHyphen)
+		default:
+			return fail(start+i, ErrInvalidChar)
+		}
+		u[n/2] |= v << (4 * (1 - n%2))
+		n++
+	}
+	return u, nil
+}
+
+const crockford = "0123456789ABCDEFGHJKMNPQRSTVWXYZ"
+
+/*
+Parse a ULID
+strict mode takes only uppercase Crockford base32
+fails with a *ParseError
+*/
+func ParseULID(s string, mode ParseMode) (ULID, error) {
+	fail := func(offset int, err error) (ULID, error) {
+		return ULID{}, &ParseError{Kind: KindULID, Input: s, Offset: offset, Err: err}
+	}
+
+	if len(s) != ulid.EncodedSize {
+		return fail(-1, ErrLength)
+	}
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if 'a' <= c && c <= 'z' {
+			if mode == Strict {
+				return fail(i, ErrCase)
+			}
+			c -= 'a' - 'A'
+		}
+		if strings.IndexByte(crockford, c) < 0 {
+			return fail(i, ErrInvalidChar)
+		}
+	}
+	// 26 base32 characters hold 130 bits, the first one may only carry 3 of them
+	if s[0] > '7' {
+		return fail(0, ErrTimestampOverflow)
+	}
+	u, err := ulid.ParseStrict(strings.ToUpper(s))
+	if err != nil {
+		return fail(-1, err)
+	}
+	return ULID(u), nil
+}
+
+/*
+Parse a tagged ID, see ParseUUID
+*/
+func ParseID[Tag any](s string, mode ParseMode) (ID[Tag], error) {
+	u, err := ParseUUID(s, mode)
+	if err != nil {
+		return ID[Tag]{}, err
+	}
+	return ID[Tag]{u}, nil
+}
+
+/*
+Version number from the top bits of byte 6, meaningful for the RFC 9562 variant
+*/
+func (u UUID) Version() int {
+	return int(u[6] >> 4)
+}
+
+/*
+Layout family of a UUID, from the top bits of byte 8
+*/
+type Variant int
+
+const (
+	VariantNCS Variant = iota
+	VariantRFC9562
+	VariantMicrosoft
+	VariantFuture
+)
+
+func (v Variant) String() string {
+	switch v {
+	case VariantNCS:
+		return "NCS"
+	case VariantRFC9562:
+		return "RFC 9562"
+	case VariantMicrosoft:
+		return "Microsoft"
+	}
+	return "Future"
+}
+
+func (u UUID) Variant() Variant {
+	switch {
+	case u[8]&0x80 == 0x00:
+		return VariantNCS
+	case u[8]&0xc0 == 0x80:
+		return VariantRFC9562
+	case u[8]&0xe0 == 0xc0:
+		return VariantMicrosoft
+	}
+	return VariantFuture
+}
+
+/*
+Timestamp embedded in a UUID version 1, 6 or 7
+fails with ErrUUIDVersion for other versions
+*/
+func (u UUID) Time() (time.Time, error) {
+	if u.Variant() == VariantRFC9562 {
+		switch u.Version() {
+		case 1:
+			return u.v1Time(), nil
+		case 6:
+			return u.v6Time(), nil
+		case 7:
+			return u.v7Time(), nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("%w %d, want 1, 6 or 7", ErrUUIDVersion, u.Version())
+}
+
+func (u UUID) v1Time() time.Time {
+	ticks := int64(binary.BigEndian.Uint16(u[6:8])&0x0fff)<<48 |
+		int64(binary.BigEndian.Uint16(u[4:6]))<<32 |
+		int64(binary.BigEndian.Uint32(u[0:4]))
+	return gregorianTime(ticks)
+}
+
+func (u UUID) v6Time() time.Time {
+	ticks := int64(binary.BigEndian.Uint32(u[0:4]))<<28 |
+		int64(binary.BigEndian.Uint16(u[4:6]))<<12 |
+		int64(binary.BigEndian.Uint16(u[6:8])&0x0fff)
+	return gregorianTime(ticks)
+}
+
+func (u UUID) v7Time() time.Time {
+	return time.UnixMilli(int64(binary.BigEndian.Uint64(u[0:8]) >> 16))
+}
+
+// 60 bit timestamps reach the year 5236, past what UnixNano can hold,
+// so seconds and nanoseconds are split before converting.
+func gregorianTime(ticks int64) time.Time {
+	unix := ticks - gregorianOffset
+	return time.Unix(unix/10_000_000, unix%10_000_000*100)
+}
+
+/*
+Timestamp embedded in a ULID or a UUID version 1, 6 or 7, accepting the lenient forms
+*/
+func Timestamp(s string) (time.Time, error) {
+	if len(s) == ulid.EncodedSize {
+		u, err := ParseULID(s, Lenient)
+		if err != nil {
+			return time.Time{}, err
+		}
+		return u.Time(), nil
+	}
+	u, err := ParseUUID(s, Lenient)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return u.Time()
+}
@@ -0,0 +1,229 @@
+package ids
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const canonicalUUID = "0190f5c2-6a4b-7c3d-8e5f-0123456789ab"
+
+func TestParseUUID(t *testing.T) {
+	want := UUID(uuid.MustParse(canonicalUUID))
+
+	tests := []struct {
+		name       string
+		input      string
+		mode       ParseMode
+		wantErr    error
+		wantOffset int
+	}{
+		{name: "canonical strict", input: canonicalUUID, mode: Strict},
+		{name: "canonical lenient", input: canonicalUUID, mode: Lenient},
+		{name: "upper strict", input: strings.ToUpper(canonicalUUID), mode: Strict, wantErr: ErrCase, wantOffset: 4},
+		{name: "upper lenient", input: strings.ToUpper(canonicalUUID), mode: Lenient},
+		{name: "no hyphens strict", input: strings.ReplaceAll(canonicalUUID, "-", ""), mode: Strict, wantErr: ErrHyphen, wantOffset: -1},
+		{name: "no hyphens lenient", input: strings.ReplaceAll(canonicalUUID, "-", ""), mode: Lenient},
+		{name: "braces strict", input: "{" + canonicalUUID + "}", mode: Strict, wantErr: ErrBraces, wantOffset: 0},
+		{name: "braces lenient", input: "{" + canonicalUUID + "}", mode: Lenient},
+		{name: "unclosed brace", input: "{" + canonicalUUID, mode: Lenient, wantErr: ErrBraces, wantOffset: 36},
+		{name: "urn strict", input: "urn:uuid:" + canonicalUUID, mode: Strict, wantErr: ErrPrefix, wantOffset: 0},
+		{name: "urn lenient", input: "URN:UUID:" + canonicalUUID, mode: Lenient},
+		{name: "empty", input: "", mode: Lenient, wantErr: ErrLength, wantOffset: -1},
+		{name: "too short", input: canonicalUUID[:35], mode: Lenient, wantErr: ErrLength, wantOffset: -1},
+		{name: "misplaced hyphen", input: "0190f5c26-a4b-7c3d-8e5f-0123456789ab", mode: Lenient, wantErr: ErrHyphen, wantOffset: 8},
+		{name: "invalid char", input: "0190f5c2-6a4b-7c3d-8e5f-0123456789ag", mode: Lenient, wantErr: ErrInvalidChar, wantOffset: 35},
+		{name: "invalid char after prefix", input: "urn:uuid:x190f5c2-6a4b-7c3d-8e5f-0123456789ab", mode: Lenient, wantErr: ErrInvalidChar, wantOffset: 9},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseUUID(tt.input, tt.mode)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("ParseUUID() error = %v, want %v", err, tt.wantErr)
+			}
+			if err == nil {
+				if got != want {
+					t.Errorf("ParseUUID() = %v, want %v", got, want)
+				}
+				return
+			}
+			var perr *ParseError
+			if !errors.As(err, &perr) {
+				t.Fatalf("ParseUUID() error %T is not a *ParseError", err)
+			}
+			if perr.Kind != KindUUID || perr.Input != tt.input || perr.Offset != tt.wantOffset {
+				t.Errorf("ParseUUID() error = %+v, want offset %d", perr, tt.wantOffset)
+			}
+		})
+	}
+}
+
+func TestParseULID(t *testing.T) {
+	const canonical = "01HWHZ2N3CQ4SJ8QX6R1B9T7VE"
+	want := mustParseULID(t, canonical)
+
+	tests := []struct {
+		name       string
+		input      string
+		mode       ParseMode
+		wantErr    error
+		wantOffset int
+	}{
+		{name: "canonical strict", input: canonical, mode: Strict},
+		{name: "lower strict", input: strings.ToLower(canonical), mode: Strict, wantErr: ErrCase, wantOffset: 2},
+		{name: "lower lenient", input: strings.ToLower(canonical), mode: Lenient},
+		{name: "too long", input: canonical + "0", mode: Lenient, wantErr: ErrLength, wantOffset: -1},
+		{name: "excluded letter", input: "01HWHZ2N3CQ4SJ8QX6R1B9T7VU", mode: Lenient, wantErr: ErrInvalidChar, wantOffset: 25},
+		{name: "hyphen", input: "01HWHZ2N3CQ4-J8QX6R1B9T7VE", mode: Lenient, wantErr: ErrInvalidChar, wantOffset: 12},
+		{name: "overflow", input: "81HWHZ2N3CQ4SJ8QX6R1B9T7VE", mode: Lenient, wantErr: ErrTimestampOverflow, wantOffset: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseULID(tt.input, tt.mode)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("ParseULID() error = %v, want %v", err, tt.wantErr)
+			}
+			if err == nil {
+				if got != want {
+					t.Errorf("ParseULID() = %v, want %v", got, want)
+				}
+				return
+			}
+			var perr *ParseError
+			if !errors.As(err, &perr) {
+				t.Fatalf("ParseULID() error %T is not a *ParseError", err)
+			}
+			if perr.Kind != KindULID || perr.Offset != tt.wantOffset {
+				t.Errorf("ParseULID() error = %+v, want offset %d", perr, tt.wantOffset)
+			}
+		})
+	}
+}
+
+func mustParseULID(t *testing.T, s string) ULID {
+	u, err := ParseULID(s, Strict)
+	if err != nil {
+		t.Fatalf("ParseULID(%v) error = %v", s, err)
+	}
+	return u
+}
+
+func TestParseError_Error(t *testing.T) {
+	_, err := ParseUUID("0190f5c2-6a4b-7c3d-8e5f-0123456789ag", Strict)
+	if want := `invalid UUID "0190f5c2-6a4b-7c3d-8e5f-0123456789ag": invalid character at offset 35`; err.Error() != want {
+		t.Errorf("Error() = %v, want %v", err, want)
+	}
+	_, err = ParseULID("short", Strict)
+	if want := `invalid ULID "short": invalid length`; err.Error() != want {
+		t.Errorf("Error() = %v, want %v", err, want)
+	}
+}
+
+func TestDetect(t *testing.T) {
+	tests := []struct {
+		input string
+		want  Kind
+	}{
+		{input: canonicalUUID, want: KindUUID},
+		{input: "{" + strings.ToUpper(canonicalUUID) + "}", want: KindUUID},
+		{input: "01HWHZ2N3CQ4SJ8QX6R1B9T7VE", want: KindULID},
+		{input: "01hwhz2n3cq4sj8qx6r1b9t7ve", want: KindULID},
+		{input: "", want: KindUnknown},
+		{input: "not an id", want: KindUnknown},
+		{input: "01HWHZ2N3CQ4SJ8QX6R1B9T7VU", want: KindUnknown},
+	}
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			if got := Detect(tt.input); got != tt.want {
+				t.Errorf("Detect() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestUUID_VersionVariant(t *testing.T) {
+	tests := []struct {
+		name        string
+		id          string
+		wantVersion int
+		wantVariant Variant
+	}{
+		{name: "v4", id: NewUUID(), wantVersion: 4, wantVariant: VariantRFC9562},
+		{name: "v5", id: NewUUIDFromObj([]byte("obj")), wantVersion: 5, wantVariant: VariantRFC9562},
+		{name: "v6", id: MustNewUUIDv6(), wantVersion: 6, wantVariant: VariantRFC9562},
+		{name: "v7", id: MustNewUUIDv7(), wantVersion: 7, wantVariant: VariantRFC9562},
+		{name: "nil", id: "00000000-0000-0000-0000-000000000000", wantVersion: 0, wantVariant: VariantNCS},
+		{name: "microsoft", id: "00000000-0000-0000-c000-000000000000", wantVersion: 0, wantVariant: VariantMicrosoft},
+		{name: "future", id: "00000000-0000-0000-e000-000000000000", wantVersion: 0, wantVariant: VariantFuture},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			u, err := ParseUUID(tt.id, Strict)
+			if err != nil {
+				t.Fatalf("ParseUUID() error = %v", err)
+			}
+			if u.Version() != tt.wantVersion || u.Variant() != tt.wantVariant {
+				t.Errorf("Version() = %v, Variant() = %v, want %v, %v", u.Version(), u.Variant(), tt.wantVersion, tt.wantVariant)
+			}
+		})
+	}
+}
+
+func TestTimestamp(t *testing.T) {
+	now := time.Date(2024, 5, 1, 12, 30, 45, 123_456_700, time.UTC)
+	tg := NewTimeGenerator(fixedClock(now), nil)
+	v7, _ := tg.NewUUIDv7()
+	v6, _ := tg.NewUUIDv6()
+	ul, _ := NewULIDGenerator(fixedClock(now), nil, OverflowError).New()
+	v1, err := uuid.NewUUID()
+	if err != nil {
+		t.Fatalf("uuid.NewUUID() error = %v", err)
+	}
+	v1Sec, v1Nsec := v1.Time().UnixTime()
+
+	tests := []struct {
+		name    string
+		id      string
+		want    time.Time
+		wantErr error
+	}{
+		{name: "v1", id: v1.String(), want: time.Unix(v1Sec, v1Nsec)},
+		{name: "v6", id: v6, want: now},
+		{name: "v7", id: v7, want: now.Truncate(time.Millisecond)},
+		{name: "v7 lenient", id: "{" + strings.ToUpper(v7) + "}", want: now.Truncate(time.Millisecond)},
+		{name: "ulid", id: ul.String(), want: now.Truncate(time.Millisecond)},
+		{name: "ulid lenient", id: strings.ToLower(ul.String()), want: now.Truncate(time.Millisecond)},
+		{name: "v6 max", id: "ffffffff-ffff-6fff-8000-000000000000", want: time.Date(5236, 3, 31, 21, 21, 0, 684_697_500, time.UTC)},
+		{name: "v1 max", id: "ffffffff-ffff-1fff-8000-000000000000", want: time.Date(5236, 3, 31, 21, 21, 0, 684_697_500, time.UTC)},
+		{name: "v1 epoch", id: "00000000-0000-1000-8000-000000000000", want: time.Date(1582, 10, 15, 0, 0, 0, 0, time.UTC)},
+		{name: "v4", id: NewUUID(), wantErr: ErrUUIDVersion},
+		{name: "invalid", id: "nope", wantErr: ErrLength},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Timestamp(tt.id)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Timestamp() error = %v, want %v", err, tt.wantErr)
+			}
+			if !got.Equal(tt.want) {
+				t.Errorf("Timestamp() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseID(t *testing.T) {
+	id := NewID[user]()
+	got, err := ParseID[user](id.String(), Strict)
+	if err != nil || got != id {
+		t.Errorf("ParseID() = %v, %v, want %v", got, err, id)
+	}
+	if _, err := ParseID[user]("nope", Strict); !errors.Is(err, ErrLength) {
+		t.Errorf("ParseID() error = %v, want %v", err, ErrLength)
+	}
+}
@@ -0,0 +1,243 @@
+package ids
+
+import (
+	"crypto/rand"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"sync"
+	"time"
+)
+
+var ErrUUIDVersion = errors.New("unexpected UUID version")
+
+const (
+	// 100-nanosecond intervals between the Gregorian epoch 1582-10-15 and the Unix epoch.
+	gregorianOffset = 0x01B21DD213814000
+
+	v7CounterBits = 42
+	v7CounterMax  = 1<<v7CounterBits - 1
+)
+
+/*
+Generator of time-ordered UUIDs version 7 and 6
+IDs from one TimeGenerator are strictly increasing, also within the same
+clock tick and when the clock goes backwards
+the zero value reads time.Now and crypto/rand
+safe for concurrent use
+*/
+type TimeGenerator struct {
+	mu      sync.Mutex
+	clock   func() time.Time
+	entropy io.Reader
+
+	// version 7: last unix millisecond and the 42 bit counter within it
+	lastMs  int64
+	counter uint64
+
+	// version 6: last 100ns tick, clock sequence and node fixed per generator
+	lastTicks int64
+	clockSeq  uint16
+	node      [6]byte
+	seeded    bool
+}
+
+/*
+Make a TimeGenerator
+nil clock means time.Now, nil entropy means crypto/rand
+*/
+func NewTimeGenerator(clock func() time.Time, entropy io.Reader) *TimeGenerator {
+	if clock == nil {
+		clock = time.Now
+	}
+	if entropy == nil {
+		entropy = rand.Reader
+	}
+	return &TimeGenerator{clock: clock, entropy: entropy}
+}
+
+// init fills in the defaults of a zero value TimeGenerator, g.mu must be held.
+func (g *TimeGenerator) init() {
+	if g.clock == nil {
+		g.clock = time.Now
+	}
+	if g.entropy == nil {
+		g.entropy = rand.Reader
+	}
+}
+
+/*
+Generate sortable UUID version 7 as a string
+*/
+func (g *TimeGenerator) NewUUIDv7() (string, error) {
+	obj, err := g.NewV7()
+	if err != nil {
+		return "", err
+	}
+	return obj.String(), nil
+}
+
+/*
+Generate sortable UUID version 7
+48 bit unix milliseconds, then a 42 bit counter starting at a random value
+each millisecond and 32 random bits
+when the counter runs out the timestamp moves on to the next millisecond
+*/
+func (g *TimeGenerator) NewV7() (UUID, error) {
+	var buf [10]byte
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.init()
+	if _, err := io.ReadFull(g.entropy, buf[:]); err != nil {
+		return UUID{}, fmt.Errorf("UUID generate failed: %w", err)
+	}
+	// top bit cleared, so a fresh counter has at least 2^41 increments left
+	seed := binary.BigEndian.Uint64(buf[2:]) & (v7CounterMax >> 1)
+
+	ms := g.clock().UnixMilli()
+	switch {
+	case ms > g.lastMs:
+		g.counter = seed
+	case g.counter < v7CounterMax:
+		ms = g.lastMs
+		g.counter++
+	default:
+		ms = g.lastMs + 1
+		g.counter = seed
+	}
+	g.lastMs = ms
+
+	var obj UUID
+	binary.BigEndian.PutUint64(obj[0:8], uint64(ms)<<16)
+	obj[6] = 0x70 | byte(g.counter>>38)&0x0f
+	obj[7] = byte(g.counter >> 30)
+	obj[8] = 0x80 | byte(g.counter>>24)&0x3f
+	obj[9] = byte(g.counter >> 16)
+	obj[10] = byte(g.counter >> 8)
+	obj[11] = byte(g.counter)
+	copy(obj[12:], buf[:4])
+	return obj, nil
+}
+
+/*
+Generate sortable UUID version 6 as a string
+*/
+func (g *TimeGenerator) NewUUIDv6() (string, error) {
+	obj, err := g.NewV6()
+	if err != nil {
+		return "", err
+	}
+	return obj.String(), nil
+}
+
+/*
+Generate sortable UUID version 6
+60 bit count of 100ns since 1582-10-15, moved one tick forward when the clock
+has not advanced, then a random clock sequence and node picked once per generator
+*/
+func (g *TimeGenerator) NewV6() (UUID, error) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.init()
+	if !g.seeded {
+		var buf [8]byte
+		if _, err := io.ReadFull(g.entropy, buf[:]); err != nil {
+			return UUID{}, fmt.Errorf("UUID generate failed: %w", err)
+		}
+		g.clockSeq = binary.BigEndian.Uint16(buf[:2]) & 0x3fff
+		copy(g.node[:], buf[2:])
+		// random nodes set the multicast bit so they never clash with a MAC address
+		g.node[0] |= 0x01
+		g.seeded = true
+	}
+
+	ticks := g.clock().UnixNano()/100 + gregorianOffset
+	if ticks <= g.lastTicks {
+		ticks = g.lastTicks + 1
+	}
+	g.lastTicks = ticks
+
+	var obj UUID
+	binary.BigEndian.PutUint32(obj[0:4], uint32(ticks>>28))
+	binary.BigEndian.PutUint16(obj[4:6], uint16(ticks>>12))
+	binary.BigEndian.PutUint16(obj[6:8], 0x6000|uint16(ticks)&0x0fff)
+	binary.BigEndian.PutUint16(obj[8:10], 0x8000|g.clockSeq)
+	copy(obj[10:], g.node[:])
+	return obj, nil
+}
+
+var defaultTimeGenerator = NewTimeGenerator(nil, nil)
+
+/*
+Generate sortable UUID version 7 from the current time
+fails when crypto/rand fails
+*/
+func NewUUIDv7E() (string, error) {
+	return defaultTimeGenerator.NewUUIDv7()
+}
+
+/*
+Generate sortable UUID version 7 from the current time
+panics when crypto/rand fails
+*/
+func MustNewUUIDv7() string {
+	id, err := NewUUIDv7E()
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+/*
+Generate sortable UUID version 6 from the current time
+fails when crypto/rand fails
+*/
+func NewUUIDv6E() (string, error) {
+	return defaultTimeGenerator.NewUUIDv6()
+}
+
+/*
+Generate sortable UUID version 6 from the current time
+panics when crypto/rand fails
+*/
+func MustNewUUIDv6() string {
+	id, err := NewUUIDv6E()
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+/*
+Extract the millisecond timestamp of a UUID version 7
+*/
+func UUIDv7Time(id string) (time.Time, error) {
+	obj, err := parseVersion(id, 7)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return obj.v7Time(), nil
+}
+
+/*
+Extract the 100ns timestamp of a UUID version 6
+*/
+func UUIDv6Time(id string) (time.Time, error) {
+	obj, err := parseVersion(id, 6)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return obj.v6Time(), nil
+}
+
+func parseVersion(id string, version int) (UUID, error) {
+	obj, err := ParseUUID(id, Lenient)
+	if err != nil {
+		return UUID{}, err
+	}
+	if obj.Version() != version {
+		return UUID{}, fmt.Errorf("%w %d, want %d", ErrUUIDVersion, obj.Version(), version)
+	}
+	return obj, nil
+}
@@ -0,0 +1,247 @@
+package ids
+
+import (
+	"bytes"
+	"errors"
+	"runtime"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func fixedClock(t time.Time) func() time.Time {
+	return func() time.Time { return t }
+}
+
+func TestTimeGenerator_NewUUIDv7(t *testing.T) {
+	now := time.Date(2024, 5, 1, 12, 30, 45, 123_456_789, time.UTC)
+	g := NewTimeGenerator(fixedClock(now), bytes.NewReader(make([]byte, 20)))
+
+	first, err := g.NewUUIDv7()
+	if err != nil {
+		t.Fatalf("NewUUIDv7() error = %v", err)
+	}
+	second, _ := g.NewUUIDv7()
+
+	if want := "018f3422-c583-7000-8000-000000000000"; first != want {
+		t.Errorf("NewUUIDv7() = %v, want %v", first, want)
+	}
+	if want := "018f3422-c583-7000-8000-000100000000"; second != want {
+		t.Errorf("NewUUIDv7() = %v, want %v", second, want)
+	}
+
+	obj := uuid.MustParse(first)
+	if obj.Version() != 7 || obj.Variant() != uuid.RFC4122 {
+		t.Errorf("NewUUIDv7() version %v variant %v, want 7 RFC4122", obj.Version(), obj.Variant())
+	}
+	got, err := UUIDv7Time(first)
+	if err != nil {
+		t.Fatalf("UUIDv7Time() error = %v", err)
+	}
+	if want := now.Truncate(time.Millisecond); !got.Equal(want) {
+		t.Errorf("UUIDv7Time() = %v, want %v", got, want)
+	}
+}
+
+func TestTimeGenerator_NewUUIDv6(t *testing.T) {
+	now := time.Date(2024, 5, 1, 12, 30, 45, 123_456_700, time.UTC)
+	g := NewTimeGenerator(fixedClock(now), bytes.NewReader(make([]byte, 8)))
+
+	first, err := g.NewUUIDv6()
+	if err != nil {
+		t.Fatalf("NewUUIDv6() error = %v", err)
+	}
+	second, _ := g.NewUUIDv6()
+
+	if want := "1ef07b6a-1c49-6f07-8000-010000000000"; first != want {
+		t.Errorf("NewUUIDv6() = %v, want %v", first, want)
+	}
+	if first >= second {
+		t.Errorf("NewUUIDv6() not increasing, first: %v, second: %v", first, second)
+	}
+
+	obj := uuid.MustParse(first)
+	if obj.Version() != 6 || obj.Variant() != uuid.RFC4122 {
+		t.Errorf("NewUUIDv6() version %v variant %v, want 6 RFC4122", obj.Version(), obj.Variant())
+	}
+	got, err := UUIDv6Time(first)
+	if err != nil {
+		t.Fatalf("UUIDv6Time() error = %v", err)
+	}
+	if !got.Equal(now) {
+		t.Errorf("UUIDv6Time() = %v, want %v", got, now)
+	}
+	got, _ = UUIDv6Time(second)
+	if want := now.Add(100 * time.Nanosecond); !got.Equal(want) {
+		t.Errorf("UUIDv6Time() = %v, want %v", got, want)
+	}
+}
+
+func TestTimeGenerator_ClockBackwards(t *testing.T) {
+	now := time.Now()
+	clock := now
+	g := NewTimeGenerator(func() time.Time { return clock }, nil)
+
+	tests := []struct {
+		name string
+		gen  func() (string, error)
+	}{
+		{name: "v7", gen: g.NewUUIDv7},
+		{name: "v6", gen: g.NewUUIDv6},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			clock = now
+			before, _ := tt.gen()
+			clock = now.Add(-time.Hour)
+			after, _ := tt.gen()
+			if before >= after {
+				t.Errorf("not increasing after the clock went back, before: %v, after: %v", before, after)
+			}
+		})
+	}
+}
+
+func TestTimeGenerator_CounterOverflow(t *testing.T) {
+	now := time.UnixMilli(1_700_000_000_000)
+	entropy := bytes.NewReader(bytes.Repeat([]byte{0xff}, 30))
+	g := NewTimeGenerator(fixedClock(now), entropy)
+
+	first, _ := g.NewUUIDv7()
+	g.counter = v7CounterMax
+	second, err := g.NewUUIDv7()
+	if err != nil {
+		t.Fatalf("NewUUIDv7() error = %v", err)
+	}
+	if first >= second {
+		t.Errorf("not increasing after counter overflow, first: %v, second: %v", first, second)
+	}
+	if got, _ := UUIDv7Time(second); !got.Equal(now.Add(time.Millisecond)) {
+		t.Errorf("UUIDv7Time() = %v, want %v", got, now.Add(time.Millisecond))
+	}
+}
+
+func TestTimeGenerator_Error(t *testing.T) {
+	g := NewTimeGenerator(nil, failingReader{})
+	if id, err := g.NewUUIDv7(); err == nil {
+		t.Errorf("NewUUIDv7() = %v, want error", id)
+	}
+	if id, err := g.NewUUIDv6(); err == nil {
+		t.Errorf("NewUUIDv6() = %v, want error", id)
+	}
+}
+
+func TestTimeGenerator_ZeroValue(t *testing.T) {
+	var g TimeGenerator
+	v7, err := g.NewV7()
+	if err != nil {
+		t.Fatalf("NewV7() error = %v", err)
+	}
+	v6, err := g.NewV6()
+	if err != nil {
+		t.Fatalf("NewV6() error = %v", err)
+	}
+	for _, id := range []UUID{v7, v6} {
+		got, err := id.Time()
+		if err != nil {
+			t.Fatalf("Time() error = %v", err)
+		}
+		if d := time.Since(got); d < 0 || d > time.Minute {
+			t.Errorf("%v Time() = %v, want about now", id, got)
+		}
+	}
+}
+
+func TestNewTimeUUIDE(t *testing.T) {
+	tests := []struct {
+		name    string
+		gen     func() (string, error)
+		version int
+	}{
+		{name: "v7", gen: NewUUIDv7E, version: 7},
+		{name: "v6", gen: NewUUIDv6E, version: 6},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			id, err := tt.gen()
+			if err != nil {
+				t.Fatalf("generate error = %v", err)
+			}
+			if obj := uuid.MustParse(id); int(obj.Version()) != tt.version {
+				t.Errorf("%v version = %v, want %v", id, obj.Version(), tt.version)
+			}
+		})
+	}
+}
+
+func TestUUIDTime_Version(t *testing.T) {
+	if _, err := UUIDv7Time(MustNewUUIDv6()); !errors.Is(err, ErrUUIDVersion) {
+		t.Errorf("UUIDv7Time(v6) error = %v, want %v", err, ErrUUIDVersion)
+	}
+	if _, err := UUIDv6Time(MustNewUUIDv7()); !errors.Is(err, ErrUUIDVersion) {
+		t.Errorf("UUIDv6Time(v7) error = %v, want %v", err, ErrUUIDVersion)
+	}
+	if _, err := UUIDv7Time("not a uuid"); err == nil {
+		t.Errorf("UUIDv7Time() parsed an invalid uuid")
+	}
+}
+
+func TestTimeGenerator_ConcurrentOrder(t *testing.T) {
+	per := 100_000
+	if testing.Short() {
+		per = 5_000
+	}
+	workers := runtime.GOMAXPROCS(0)
+
+	tests := []struct {
+		name string
+		gen  func(g *TimeGenerator) func() (string, error)
+	}{
+		{name: "v7", gen: func(g *TimeGenerator) func() (string, error) { return g.NewUUIDv7 }},
+		{name: "v6", gen: func(g *TimeGenerator) func() (string, error) { return g.NewUUIDv6 }},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gen := tt.gen(NewTimeGenerator(nil, nil))
+			results := make([][]string, workers)
+			var wg sync.WaitGroup
+			for w := 0; w < workers; w++ {
+				wg.Add(1)
+				go func(w int) {
+					defer wg.Done()
+					ids := make([]string, per)
+					for i := range ids {
+						id, err := gen()
+						if err != nil {
+							t.Errorf("generate error = %v", err)
+							return
+						}
+						ids[i] = id
+					}
+					results[w] = ids
+				}(w)
+			}
+			wg.Wait()
+
+			var all []string
+			for _, ids := range results {
+				// each goroutine sees the shared generator move strictly forward
+				for i := 1; i < len(ids); i++ {
+					if ids[i-1] >= ids[i] {
+						t.Fatalf("invalid order, before: %v, current: %v", ids[i-1], ids[i])
+					}
+				}
+				all = append(all, ids...)
+			}
+			sort.Strings(all)
+			for i := 1; i < len(all); i++ {
+				if all[i-1] == all[i] {
+					t.Fatalf("duplicate uuid %v", all[i])
+				}
+			}
+		})
+	}
+}
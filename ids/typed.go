@@ -0,0 +1,237 @@
+package ids
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/oklog/ulid"
+)
+
+/*
+UUID value in its 16 byte binary form
+the zero value is the nil UUID
+*/
+type UUID [16]byte
+
+/*
+Canonical lowercase form xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx
+*/
+func (u UUID) String() string {
+	return uuid.UUID(u).String()
+}
+
+/*
+Copy of the 16 bytes
+*/
+func (u UUID) Bytes() []byte {
+	return bytes.Clone(u[:])
+}
+
+func (u UUID) IsZero() bool {
+	return u == UUID{}
+}
+
+/*
+Compare byte by byte, which matches the order of the string form
+*/
+func (u UUID) Compare(other UUID) int {
+	return bytes.Compare(u[:], other[:])
+}
+
+func (u UUID) MarshalText() ([]byte, error) {
+	return []byte(u.String()), nil
+}
+
+/*
+Decode any form ParseUUID accepts in lenient mode
+*/
+func (u *UUID) UnmarshalText(data []byte) error {
+	obj, err := ParseUUID(string(data), Lenient)
+	if err != nil {
+		return err
+	}
+	*u = obj
+	return nil
+}
+
+func (u UUID) MarshalJSON() ([]byte, error) {
+	return json.Marshal(u.String())
+}
+
+/*
+Decode a JSON string, null leaves u unchanged
+*/
+func (u *UUID) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	return u.UnmarshalText([]byte(s))
+}
+
+/*
+Scan a string, the text or 16 byte binary form, or nil as the nil UUID
+*/
+func (u *UUID) Scan(src any) error {
+	switch src := src.(type) {
+	case nil:
+		*u = UUID{}
+		return nil
+	case string:
+		return u.UnmarshalText([]byte(src))
+	case []byte:
+		if len(src) == len(u) {
+			copy(u[:], src)
+			return nil
+		}
+		return u.UnmarshalText(src)
+	}
+	return fmt.Errorf("unable to scan %T into UUID", src)
+}
+
+/*
+Store as the canonical string
+*/
+func (u UUID) Value() (driver.Value, error) {
+	return u.String(), nil
+}
+
+/*
+ULID value in its 16 byte binary form
+the zero value is the ULID 00000000000000000000000000
+*/
+type ULID [16]byte
+
+/*
+Canonical 26 character Crockford base32 form
+*/
+func (u ULID) String() string {
+	return ulid.ULID(u).String()
+}
+
+/*
+Copy of the 16 bytes
+*/
+func (u ULID) Bytes() []byte {
+	return bytes.Clone(u[:])
+}
+
+func (u ULID) IsZero() bool {
+	return u == ULID{}
+}
+
+/*
+Compare byte by byte, which orders by time first
+*/
+func (u ULID) Compare(other ULID) int {
+	return ulid.ULID(u).Compare(ulid.ULID(other))
+}
+
+/*
+Millisecond timestamp of the ULID
+*/
+func (u ULID) Time() time.Time {
+	return ulid.Time(ulid.ULID(u).Time())
+}
+
+func (u ULID) MarshalText() ([]byte, error) {
+	return []byte(u.String()), nil
+}
+
+/*
+Decode any form ParseULID accepts in lenient mode
+*/
+func (u *ULID) UnmarshalText(data []byte) error {
+	obj, err := ParseULID(string(data), Lenient)
+	if err != nil {
+		return err
+	}
+	*u = obj
+	return nil
+}
+
+func (u ULID) MarshalJSON() ([]byte, error) {
+	return json.Marshal(u.String())
+}
+
+/*
+Decode a JSON string, null leaves u unchanged
+*/
+func (u *ULID) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	return u.UnmarshalText([]byte(s))
+}
+
+/*
+Scan a string, the text or 16 byte binary form, or nil as the zero ULID
+*/
+func (u *ULID) Scan(src any) error {
+	switch src := src.(type) {
+	case nil:
+		*u = ULID{}
+		return nil
+	case string:
+		return u.UnmarshalText([]byte(src))
+	case []byte:
+		if len(src) == len(u) {
+			copy(u[:], src)
+			return nil
+		}
+		return u.UnmarshalText(src)
+	}
+	return fmt.Errorf("%w, got %T", ulid.ErrScanValue, src)
+}
+
+/*
+Store as the canonical string
+*/
+func (u ULID) Value() (driver.Value, error) {
+	return u.String(), nil
+}
+
+/*
+UUID tagged with the kind of thing it identifies
+ID[User] and ID[Order] are different types, so one cannot be passed for the other
+
+	type User struct{}
+	type UserID = ids.ID[User]
+*/
+type ID[Tag any] struct {
+	UUID
+}
+
+/*
+Generate a random ID from crypto/rand
+panics when crypto/rand fails
+*/
+func NewID[Tag any]() ID[Tag] {
+	obj, err := defaultGenerator.New()
+	if err != nil {
+		panic(err)
+	}
+	return ID[Tag]{obj}
+}
+
+/*
+Tag an existing UUID
+*/
+func IDFrom[Tag any](u UUID) ID[Tag] {
+	return ID[Tag]{u}
+}
+
+func (id ID[Tag]) Compare(other ID[Tag]) int {
+	return id.UUID.Compare(other.UUID)
+}
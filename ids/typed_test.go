@@ -0,0 +1,212 @@
+package ids
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestUUID(t *testing.T) {
+	const text = "0190f5c2-6a4b-7c3d-8e5f-0123456789ab"
+	var u UUID
+	if err := u.UnmarshalText([]byte(text)); err != nil {
+		t.Fatalf("UnmarshalText() error = %v", err)
+	}
+	if got := u.String(); got != text {
+		t.Errorf("String() = %v, want %v", got, text)
+	}
+	if got := u.Bytes(); len(got) != 16 || got[0] != 0x01 || got[15] != 0xab {
+		t.Errorf("Bytes() = %x", got)
+	}
+	u.Bytes()[0] = 0xff
+	if u[0] != 0x01 {
+		t.Errorf("Bytes() aliases the UUID")
+	}
+	if u.IsZero() || !(UUID{}).IsZero() {
+		t.Errorf("IsZero() mismatch")
+	}
+	if err := u.UnmarshalText([]byte("not a uuid")); err == nil {
+		t.Errorf("UnmarshalText() parsed an invalid uuid")
+	}
+
+	small, _ := defaultTimeGenerator.NewV7()
+	large, _ := defaultTimeGenerator.NewV7()
+	if small.Compare(large) != -1 || large.Compare(small) != 1 || small.Compare(small) != 0 {
+		t.Errorf("Compare() mismatch, small: %v, large: %v", small, large)
+	}
+}
+
+func TestUUID_JSON(t *testing.T) {
+	type doc struct {
+		ID  UUID  `json:"id"`
+		Ref *UUID `json:"ref"`
+	}
+	id, _ := defaultGenerator.New()
+
+	data, err := json.Marshal(doc{ID: id})
+	if err != nil {
+		t.Fatalf("Marshal() error = %v", err)
+	}
+	if want := `{"id":"` + id.String() + `","ref":null}`; string(data) != want {
+		t.Errorf("Marshal() = %s, want %s", data, want)
+	}
+
+	var got doc
+	if err := json.Unmarshal(data, &got); err != nil {
+		t.Fatalf("Unmarshal() error = %v", err)
+	}
+	if !reflect.DeepEqual(got, doc{ID: id}) {
+		t.Errorf("Unmarshal() = %v, want %v", got, doc{ID: id})
+	}
+	if err := json.Unmarshal([]byte(`{"id":"nope"}`), &got); err == nil {
+		t.Errorf("Unmarshal() parsed an invalid uuid")
+	}
+	if err := json.Unmarshal([]byte(`{"id":1}`), &got); err == nil {
+		t.Errorf("Unmarshal() parsed a number")
+	}
+}
+
+func TestUUID_SQL(t *testing.T) {
+	id, _ := defaultGenerator.New()
+	value, err := id.Value()
+	if err != nil || value != id.String() {
+		t.Errorf("Value() = %v, %v, want %v", value, err, id.String())
+	}
+
+	tests := []struct {
+		name    string
+		src     any
+		want    UUID
+		wantErr bool
+	}{
+		{name: "string", src: id.String(), want: id},
+		{name: "text bytes", src: []byte(id.String()), want: id},
+		{name: "binary", src: id.Bytes(), want: id},
+		{name: "nil", src: nil, want: UUID{}},
+		{name: "invalid", src: "nope", wantErr: true},
+		{name: "unsupported", src: 42, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := id
+			err := got.Scan(tt.src)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Scan() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err == nil && got != tt.want {
+				t.Errorf("Scan() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestULID(t *testing.T) {
+	now := time.Date(2024, 5, 1, 12, 30, 45, 123_000_000, time.UTC)
+	g := NewULIDGenerator(fixedClock(now), nil, OverflowError)
+	first, _ := g.New()
+	second, _ := g.New()
+
+	if !first.Time().Equal(now) {
+		t.Errorf("Time() = %v, want %v", first.Time(), now)
+	}
+	if first.Compare(second) != -1 || second.Compare(first) != 1 || first.Compare(first) != 0 {
+		t.Errorf("Compare() mismatch, first: %v, second: %v", first, second)
+	}
+	if first.IsZero() || !(ULID{}).IsZero() {
+		t.Errorf("IsZero() mismatch")
+	}
+	if len(first.String()) != 26 || len(first.Bytes()) != 16 {
+		t.Errorf("String() = %v, Bytes() = %x", first.String(), first.Bytes())
+	}
+
+	var parsed ULID
+	if err := parsed.UnmarshalText([]byte(first.String())); err != nil || parsed != first {
+		t.Errorf("UnmarshalText() = %v, %v, want %v", parsed, err, first)
+	}
+
+	data, err := json.Marshal(first)
+	if err != nil || string(data) != `"`+first.String()+`"` {
+		t.Errorf("Marshal() = %s, %v", data, err)
+	}
+	var decoded ULID
+	if err := json.Unmarshal(data, &decoded); err != nil || decoded != first {
+		t.Errorf("Unmarshal() = %v, %v, want %v", decoded, err, first)
+	}
+}
+
+func TestULID_SQL(t *testing.T) {
+	id := mustULID(t)
+	value, err := id.Value()
+	if err != nil || value != id.String() {
+		t.Errorf("Value() = %v, %v, want %v", value, err, id.String())
+	}
+
+	tests := []struct {
+		name    string
+		src     any
+		want    ULID
+		wantErr bool
+	}{
+		{name: "string", src: id.String(), want: id},
+		{name: "text bytes", src: []byte(id.String()), want: id},
+		{name: "binary", src: id.Bytes(), want: id},
+		{name: "nil", src: nil, want: ULID{}},
+		{name: "invalid", src: "nope", wantErr: true},
+		{name: "unsupported", src: 42, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := id
+			err := got.Scan(tt.src)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Scan() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err == nil && got != tt.want {
+				t.Errorf("Scan() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func mustULID(t *testing.T) ULID {
+	id, err := NewULIDGenerator(nil, nil, OverflowError).New()
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+	return id
+}
+
+type user struct{}
+type order struct{}
+
+func TestID(t *testing.T) {
+	a := NewID[user]()
+	b := NewID[user]()
+	if a == b || a.IsZero() {
+		t.Errorf("NewID() = %v, %v", a, b)
+	}
+	if a.Compare(b) != a.UUID.Compare(b.UUID) {
+		t.Errorf("Compare() does not follow the UUID order")
+	}
+
+	// IDs with different tags do not convert into each other, only through the UUID.
+	o := IDFrom[order](a.UUID)
+	if o.String() != a.String() {
+		t.Errorf("IDFrom() = %v, want %v", o, a)
+	}
+
+	data, err := json.Marshal(struct{ User ID[user] }{a})
+	if err != nil || string(data) != `{"User":"`+a.String()+`"}` {
+		t.Errorf("Marshal() = %s, %v", data, err)
+	}
+	var decoded struct{ User ID[user] }
+	if err := json.Unmarshal(data, &decoded); err != nil || decoded.User != a {
+		t.Errorf("Unmarshal() = %v, %v, want %v", decoded.User, err, a)
+	}
+
+	var scanned ID[user]
+	if err := scanned.Scan(a.String()); err != nil || scanned != a {
+		t.Errorf("Scan() = %v, %v, want %v", scanned, err, a)
+	}
+}
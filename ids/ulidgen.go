@@ -0,0 +1,87 @@
+package ids
+
+import (
+	"crypto/rand"
+	"fmt"
+	"io"
+	"sync"
+	"time"
+
+	"github.com/oklog/ulid"
+)
+
+/*
+What a ULIDGenerator does when the monotonic entropy of one millisecond runs out
+*/
+type ULIDOverflow int
+
+const (
+	// fail with an error wrapping ulid.ErrMonotonicOverflow
+	OverflowError ULIDOverflow = iota
+	// wait for the next millisecond and carry on from there
+	OverflowWait
+)
+
+/*
+Generator of sortable ULIDs
+IDs from one ULIDGenerator are strictly increasing, also within the same
+millisecond and when the clock goes backwards
+the zero value reads time.Now and crypto/rand and fails on overflow
+safe for concurrent use
+*/
+type ULIDGenerator struct {
+	mu       sync.Mutex
+	clock    func() time.Time
+	entropy  io.Reader
+	overflow ULIDOverflow
+	lastMs   uint64
+}
+
+/*
+Make a ULIDGenerator
+nil clock means time.Now, nil entropy means crypto/rand
+*/
+func NewULIDGenerator(clock func() time.Time, entropy io.Reader, overflow ULIDOverflow) *ULIDGenerator {
+	if clock == nil {
+		clock = time.Now
+	}
+	if entropy == nil {
+		entropy = rand.Reader
+	}
+	return &ULIDGenerator{clock: clock, entropy: ulid.Monotonic(entropy, 0), overflow: overflow}
+}
+
+/*
+Generate sortable ULID
+*/
+func (g *ULIDGenerator) New() (ULID, error) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	if g.clock == nil {
+		g.clock = time.Now
+	}
+	if g.entropy == nil {
+		g.entropy = ulid.Monotonic(rand.Reader, 0)
+	}
+
+	ms := max(ulid.Timestamp(g.clock()), g.lastMs)
+	id, err := ulid.New(ms, g.entropy)
+	if err == ulid.ErrMonotonicOverflow && g.overflow == OverflowWait {
+		// a clock that went backwards would never catch up, so only wait out the current millisecond
+		if now := g.clock(); ulid.Timestamp(now) == ms {
+			time.Sleep(ulid.Time(ms + 1).Sub(now))
+		}
+		ms++
+		id, err = ulid.New(ms, g.entropy)
+	}
+	if err == ulid.ErrMonotonicOverflow {
+		// the monotonic reader now holds wrapped-around entropy for ms,
+		// so the next ID must come from a later millisecond
+		g.lastMs = ms + 1
+	}
+	if err != nil {
+		return ULID{}, fmt.Errorf("ULID generate failed: %w", err)
+	}
+	g.lastMs = ms
+	return ULID(id), nil
+}
@@ -0,0 +1,166 @@
+package ids
+
+import (
+	"errors"
+	"runtime"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/oklog/ulid"
+)
+
+// maxEntropy yields ten 0xff bytes, so the second ULID of a millisecond overflows.
+// The rest is 0x01, since ulid.Monotonic keeps reading until it gets a non-zero increment.
+type maxEntropy struct {
+	n int
+}
+
+func (m *maxEntropy) Read(p []byte) (int, error) {
+	for i := range p {
+		p[i] = 0x01
+		if m.n < 10 {
+			p[i] = 0xff
+			m.n++
+		}
+	}
+	return len(p), nil
+}
+
+func TestULIDGenerator_New(t *testing.T) {
+	now := time.Date(2024, 5, 1, 12, 30, 45, 123_000_000, time.UTC)
+	g := NewULIDGenerator(fixedClock(now), nil, OverflowError)
+
+	prev, err := g.New()
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+	if got := prev.Time(); !got.Equal(now) {
+		t.Errorf("New() time = %v, want %v", got, now)
+	}
+	for i := 0; i < 100; i++ {
+		id, err := g.New()
+		if err != nil {
+			t.Fatalf("New() error = %v", err)
+		}
+		if prev.Compare(id) >= 0 {
+			t.Fatalf("invalid ULID order, before: %v, current: %v", prev, id)
+		}
+		prev = id
+	}
+}
+
+func TestULIDGenerator_ZeroValue(t *testing.T) {
+	var g ULIDGenerator
+	first, err := g.New()
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+	second, err := g.New()
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+	if first.Compare(second) >= 0 {
+		t.Errorf("invalid ULID order, before: %v, current: %v", first, second)
+	}
+	if d := time.Since(first.Time()); d < 0 || d > time.Minute {
+		t.Errorf("New() time = %v, want about now", first.Time())
+	}
+}
+
+func TestULIDGenerator_ClockBackwards(t *testing.T) {
+	now := time.Now()
+	clock := now
+	g := NewULIDGenerator(func() time.Time { return clock }, nil, OverflowError)
+
+	before, _ := g.New()
+	clock = now.Add(-time.Hour)
+	after, err := g.New()
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+	if before.Compare(after) >= 0 {
+		t.Errorf("not increasing after the clock went back, before: %v, after: %v", before, after)
+	}
+}
+
+func TestULIDGenerator_Overflow(t *testing.T) {
+	now := time.UnixMilli(1_700_000_000_000)
+
+	tests := []struct {
+		name     string
+		overflow ULIDOverflow
+		wantErr  error
+		wantTime time.Time
+	}{
+		{name: "error", overflow: OverflowError, wantErr: ulid.ErrMonotonicOverflow, wantTime: now.Add(time.Millisecond)},
+		{name: "wait", overflow: OverflowWait, wantTime: now.Add(time.Millisecond)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewULIDGenerator(fixedClock(now), &maxEntropy{}, tt.overflow)
+			first, err := g.New()
+			if err != nil {
+				t.Fatalf("New() error = %v", err)
+			}
+			second, err := g.New()
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("New() error = %v, want %v", err, tt.wantErr)
+			}
+			if err != nil {
+				// after a failed call the generator carries on from the next millisecond
+				if second, err = g.New(); err != nil {
+					t.Fatalf("New() after overflow error = %v", err)
+				}
+			}
+			if first.Compare(second) >= 0 {
+				t.Errorf("invalid ULID order, before: %v, current: %v", first, second)
+			}
+			if got := second.Time(); !got.Equal(tt.wantTime) {
+				t.Errorf("New() time = %v, want %v", got, tt.wantTime)
+			}
+		})
+	}
+}
+
+func TestULIDGenerator_Concurrent(t *testing.T) {
+	per := 20_000
+	if testing.Short() {
+		per = 2_000
+	}
+	workers := 4 * runtime.GOMAXPROCS(0)
+	g := NewULIDGenerator(nil, nil, OverflowWait)
+
+	results := make([][]ULID, workers)
+	var wg sync.WaitGroup
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func(w int) {
+			defer wg.Done()
+			ids := make([]ULID, per)
+			for i := range ids {
+				id, err := g.New()
+				if err != nil {
+					t.Errorf("New() error = %v", err)
+					return
+				}
+				ids[i] = id
+			}
+			results[w] = ids
+		}(w)
+	}
+	wg.Wait()
+
+	seen := make(map[ULID]struct{}, workers*per)
+	for _, ids := range results {
+		for i, id := range ids {
+			if i > 0 && ids[i-1].Compare(id) >= 0 {
+				t.Fatalf("invalid ULID order, before: %v, current: %v", ids[i-1], id)
+			}
+			if _, ok := seen[id]; ok {
+				t.Fatalf("duplicate ULID %v", id)
+			}
+			seen[id] = struct{}{}
+		}
+	}
+}
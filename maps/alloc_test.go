@@ -0,0 +1,119 @@
+package maps
+
+import (
+	"strconv"
+	"testing"
+)
+
+func allocInput(n int) map[int]int {
+	src := make(map[int]int, n)
+	for i := 0; i < n; i++ {
+		src[i] = i
+	}
+	return src
+}
+
+var (
+	allocSrc    = allocInput(1000)
+	allocAll    = func(k, v int) bool { return true }
+	allocIsEven = func(k, v int) bool { return v%2 == 0 }
+	allocSparse = func(k, v int) bool { return v%200 == 0 }
+	allocNone   = func(k, v int) bool { return false }
+	allocHalf   = func(k, v int) (int, bool) { return v / 2, v%100 == 0 }
+	allocKeep   = func(k, v int) int { return v }
+
+	// results are stored in sinks so escape analysis cannot keep them on the stack
+	sinkMap   map[int]int
+	sinkSlice []int
+)
+
+func TestAllocs(t *testing.T) {
+	if raceEnabled {
+		t.Skip("allocation counts differ under the race detector")
+	}
+	buf := make([]int, 0, len(allocSrc))
+
+	// how many allocations a map presized for allocSrc takes depends on the runtime's
+	// table layout, so the functions building one are held to this instead of a constant
+	presized := testing.AllocsPerRun(100, func() {
+		m := make(map[int]int, len(allocSrc))
+		for k, v := range allocSrc {
+			m[k] = v
+		}
+		sinkMap = m
+	})
+
+	tests := []struct {
+		name   string
+		fn     func()
+		want   float64
+		atMost bool
+	}{
+		// Filter and Map grow with the matches, so only a bound holds across platforms:
+		// 5 entries fit in a map's first group, 10 values in at most 5 appends
+		{name: "Filter/sparse", fn: func() { sinkMap = Filter(allocSrc, allocSparse) }, want: 2, atMost: true},
+		{name: "Filter/none", fn: func() { sinkMap = Filter(allocSrc, allocNone) }, want: 1, atMost: true},
+		{name: "Map/sparse", fn: func() { sinkSlice = Map(allocSrc, allocHalf) }, want: 5, atMost: true},
+		{name: "Map/none", fn: func() { sinkSlice = Map(allocSrc, func(k, v int) (int, bool) { return v, false }) }, want: 0},
+		{name: "AppendMap", fn: func() { sinkSlice = AppendMap(buf[:0], allocSrc, allocHalf) }, want: 0},
+		{name: "MapValues", fn: func() { sinkMap = MapValues(allocSrc, allocKeep) }, want: presized, atMost: true},
+		{name: "MapKeys", fn: func() { sinkMap = MapKeys(allocSrc, allocKeep) }, want: presized, atMost: true},
+		{name: "Remove", fn: func() { sinkMap = Remove(allocSrc, 500) }, want: presized, atMost: true},
+		{name: "HasKey", fn: func() { HasKey(allocSrc, 999) }, want: 0},
+		{name: "HasValue", fn: func() { HasValue(allocSrc, 999) }, want: 0},
+		{name: "Every", fn: func() { Every(allocSrc, allocAll) }, want: 0},
+		{name: "Some", fn: func() { Some(allocSrc, allocIsEven) }, want: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := testing.AllocsPerRun(100, tt.fn)
+			switch {
+			case tt.atMost && got > tt.want:
+				t.Errorf("%s allocs = %v, want at most %v", tt.name, got, tt.want)
+			case !tt.atMost && got != tt.want:
+				t.Errorf("%s allocs = %v, want %v", tt.name, got, tt.want)
+			}
+		})
+	}
+}
+
+// dense filters keep every other entry, sparse ones keep 1 in 100
+func BenchmarkFilterMap(b *testing.B) {
+	sparse := func(k, v int) bool { return v%100 == 0 }
+	evenHalf := func(k, v int) (int, bool) { return v / 2, v%2 == 0 }
+	for _, n := range []int{10, 1000, 100000} {
+		src := allocInput(n)
+		buf := make([]int, 0, n)
+		b.Run("Filter/dense/n="+strconv.Itoa(n), func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				sinkMap = Filter(src, allocIsEven)
+			}
+		})
+		b.Run("Filter/sparse/n="+strconv.Itoa(n), func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				sinkMap = Filter(src, sparse)
+			}
+		})
+		b.Run("Map/dense/n="+strconv.Itoa(n), func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				sinkSlice = Map(src, evenHalf)
+			}
+		})
+		b.Run("Map/sparse/n="+strconv.Itoa(n), func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				sinkSlice = Map(src, allocHalf)
+			}
+		})
+		b.Run("AppendMap/n="+strconv.Itoa(n), func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				buf = AppendMap(buf[:0], src, allocHalf)
+			}
+		})
+	}
+}
@@ -0,0 +1,152 @@
+package maps
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"iter"
+	stdmaps "maps"
+)
+
+var ErrDuplicateValue = errors.New("duplicate value")
+
+// BiMap is a one-to-one map with constant-time lookups by key and by value.
+// Every value belongs to at most one key. The zero value is an empty map ready to use.
+// A BiMap is not safe for concurrent use.
+type BiMap[K comparable, V comparable] struct {
+	forward map[K]V
+	inverse map[V]K
+}
+
+func NewBiMap[K comparable, V comparable]() *BiMap[K, V] {
+	return &BiMap[K, V]{forward: make(map[K]V), inverse: make(map[V]K)}
+}
+
+// NewBiMapFrom copies elms into a new BiMap. It fails with ErrDuplicateValue
+// when two keys share a value.
+func NewBiMapFrom[K comparable, V comparable](elms map[K]V) (*BiMap[K, V], error) {
+	b := &BiMap[K, V]{forward: make(map[K]V, len(elms)), inverse: make(map[V]K, len(elms))}
+	for k, v := range elms {
+		if err := b.Put(k, v); err != nil {
+			return nil, err
+		}
+	}
+	return b, nil
+}
+
+// Put maps key to value, replacing the previous value of key.
+// It fails with ErrDuplicateValue and leaves b unchanged when value belongs to another key.
+func (b *BiMap[K, V]) Put(key K, value V) error {
+	if k, ok := b.inverse[value]; ok && k != key {
+		return fmt.Errorf("%w %v for key %v, already held by %v", ErrDuplicateValue, value, key, k)
+	}
+	b.set(key, value)
+	return nil
+}
+
+// ForcePut maps key to value, dropping any other key that held value.
+func (b *BiMap[K, V]) ForcePut(key K, value V) {
+	if k, ok := b.inverse[value]; ok {
+		delete(b.forward, k)
+	}
+	b.set(key, value)
+}
+
+func (b *BiMap[K, V]) set(key K, value V) {
+	if b.forward == nil {
+		b.forward = make(map[K]V)
+		b.inverse = make(map[V]K)
+	}
+	if v, ok := b.forward[key]; ok {
+		delete(b.inverse, v)
+	}
+	b.forward[key] = value
+	b.inverse[value] = key
+}
+
+func (b *BiMap[K, V]) Get(key K) (V, bool) {
+	v, ok := b.forward[key]
+	return v, ok
+}
+
+func (b *BiMap[K, V]) GetKey(value V) (K, bool) {
+	k, ok := b.inverse[value]
+	return k, ok
+}
+
+func (b *BiMap[K, V]) HasKey(key K) bool {
+	_, ok := b.forward[key]
+	return ok
+}
+
+func (b *BiMap[K, V]) HasValue(value V) bool {
+	_, ok := b.inverse[value]
+	return ok
+}
+
+func (b *BiMap[K, V]) Delete(key K) {
+	if v, ok := b.forward[key]; ok {
+		delete(b.forward, key)
+		delete(b.inverse, v)
+	}
+}
+
+func (b *BiMap[K, V]) DeleteValue(value V) {
+	if k, ok := b.inverse[value]; ok {
+		delete(b.inverse, value)
+		delete(b.forward, k)
+	}
+}
+
+func (b *BiMap[K, V]) Len() int {
+	return len(b.forward)
+}
+
+// All iterates over the key/value pairs in unspecified order.
+// b must not be modified during the iteration.
+func (b *BiMap[K, V]) All() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for k, v := range b.forward {
+			if !yield(k, v) {
+				return
+			}
+		}
+	}
+}
+
+// ToMap returns a copy of the key to value map.
+func (b *BiMap[K, V]) ToMap() map[K]V {
+	return stdmaps.Clone(b.forward)
+}
+
+// Inverse returns a new BiMap with keys and values swapped.
+func (b *BiMap[K, V]) Inverse() *BiMap[V, K] {
+	return &BiMap[V, K]{forward: b.ToInverseMap(), inverse: b.ToMap()}
+}
+
+// ToInverseMap returns a copy of the value to key map.
+func (b *BiMap[K, V]) ToInverseMap() map[V]K {
+	return stdmaps.Clone(b.inverse)
+}
+
+// MarshalJSON encodes b as a JSON object of its key to value map.
+func (b BiMap[K, V]) MarshalJSON() ([]byte, error) {
+	if b.forward == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(b.forward)
+}
+
+// UnmarshalJSON decodes a JSON object, failing with ErrDuplicateValue when two keys share a value.
+func (b *BiMap[K, V]) UnmarshalJSON(data []byte) error {
+	var elms map[K]V
+	if err := json.Unmarshal(data, &elms); err != nil {
+		return err
+	}
+	decoded, err := NewBiMapFrom(elms)
+	if err != nil {
+		return err
+	}
+	*b = *decoded
+	return nil
+}
@@ -0,0 +1,189 @@
+package maps
+
+import (
+	"encoding/json"
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestBiMap_Put(t *testing.T) {
+	type put struct {
+		key     string
+		value   int
+		wantErr error
+	}
+	tests := []struct {
+		name        string
+		puts        []put
+		want        map[string]int
+		wantInverse map[int]string
+	}{
+		{
+			name:        "unique values",
+			puts:        []put{{"a", 1, nil}, {"b", 2, nil}},
+			want:        map[string]int{"a": 1, "b": 2},
+			wantInverse: map[int]string{1: "a", 2: "b"},
+		},
+		{
+			name:        "same pair twice",
+			puts:        []put{{"a", 1, nil}, {"a", 1, nil}},
+			want:        map[string]int{"a": 1},
+			wantInverse: map[int]string{1: "a"},
+		},
+		{
+			name:        "replace value of key",
+			puts:        []put{{"a", 1, nil}, {"a", 2, nil}},
+			want:        map[string]int{"a": 2},
+			wantInverse: map[int]string{2: "a"},
+		},
+		{
+			name:        "value held by another key",
+			puts:        []put{{"a", 1, nil}, {"b", 1, ErrDuplicateValue}},
+			want:        map[string]int{"a": 1},
+			wantInverse: map[int]string{1: "a"},
+		},
+		{
+			name:        "freed value can be reused",
+			puts:        []put{{"a", 1, nil}, {"a", 2, nil}, {"b", 1, nil}},
+			want:        map[string]int{"a": 2, "b": 1},
+			wantInverse: map[int]string{1: "b", 2: "a"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var b BiMap[string, int]
+			for _, p := range tt.puts {
+				if err := b.Put(p.key, p.value); !errors.Is(err, p.wantErr) {
+					t.Errorf("Put(%v, %v) error = %v, want %v", p.key, p.value, err, p.wantErr)
+				}
+			}
+			if got := b.ToMap(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ToMap() = %v, want %v", got, tt.want)
+			}
+			if got := b.ToInverseMap(); !reflect.DeepEqual(got, tt.wantInverse) {
+				t.Errorf("ToInverseMap() = %v, want %v", got, tt.wantInverse)
+			}
+		})
+	}
+}
+
+func TestBiMap_ForcePut(t *testing.T) {
+	b := NewBiMap[string, int]()
+	b.ForcePut("a", 1)
+	b.ForcePut("b", 1)
+	if got, want := b.ToMap(), map[string]int{"b": 1}; !reflect.DeepEqual(got, want) {
+		t.Errorf("ToMap() = %v, want %v", got, want)
+	}
+	if k, _ := b.GetKey(1); k != "b" {
+		t.Errorf("GetKey(1) = %v, want b", k)
+	}
+}
+
+func TestBiMap_Lookup(t *testing.T) {
+	b, err := NewBiMapFrom(map[string]int{"a": 1, "b": 2, "c": 3})
+	if err != nil {
+		t.Fatalf("NewBiMapFrom() error = %v", err)
+	}
+
+	if v, ok := b.Get("b"); !ok || v != 2 {
+		t.Errorf("Get(b) = %v, %v, want 2, true", v, ok)
+	}
+	if k, ok := b.GetKey(3); !ok || k != "c" {
+		t.Errorf("GetKey(3) = %v, %v, want c, true", k, ok)
+	}
+	if _, ok := b.GetKey(4); ok {
+		t.Errorf("GetKey(4) found a key")
+	}
+	if !b.HasKey("a") || b.HasKey("d") {
+		t.Errorf("HasKey() mismatch")
+	}
+	if !b.HasValue(1) || b.HasValue(4) {
+		t.Errorf("HasValue() mismatch")
+	}
+
+	b.Delete("a")
+	b.DeleteValue(2)
+	if b.Len() != 1 || b.HasValue(1) || b.HasKey("b") {
+		t.Errorf("after Delete, ToMap() = %v, ToInverseMap() = %v", b.ToMap(), b.ToInverseMap())
+	}
+
+	inv := b.Inverse()
+	if k, ok := inv.Get(3); !ok || k != "c" {
+		t.Errorf("Inverse().Get(3) = %v, %v, want c, true", k, ok)
+	}
+}
+
+func TestNewBiMapFrom_Duplicate(t *testing.T) {
+	if _, err := NewBiMapFrom(map[string]int{"a": 1, "b": 1}); !errors.Is(err, ErrDuplicateValue) {
+		t.Errorf("NewBiMapFrom() error = %v, want %v", err, ErrDuplicateValue)
+	}
+}
+
+func TestBiMap_All(t *testing.T) {
+	src := map[string]int{"a": 1, "b": 2, "c": 3}
+	b, _ := NewBiMapFrom(src)
+
+	got := make(map[string]int)
+	for k, v := range b.All() {
+		got[k] = v
+	}
+	if !reflect.DeepEqual(got, src) {
+		t.Errorf("All() = %v, want %v", got, src)
+	}
+
+	n := 0
+	for range b.All() {
+		n++
+		break
+	}
+	if n != 1 {
+		t.Errorf("All() yielded %d values after break, want 1", n)
+	}
+}
+
+func TestBiMap_JSON(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    string
+		want    map[string]int
+		wantErr error
+	}{
+		{name: "object", data: `{"a":1,"b":2}`, want: map[string]int{"a": 1, "b": 2}},
+		{name: "empty", data: `{}`, want: map[string]int{}},
+		{name: "duplicate value", data: `{"a":1,"b":1}`, wantErr: ErrDuplicateValue},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var b BiMap[string, int]
+			err := json.Unmarshal([]byte(tt.data), &b)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Unmarshal() error = %v, want %v", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			if got := b.ToMap(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Unmarshal() = %v, want %v", got, tt.want)
+			}
+
+			data, err := json.Marshal(&b)
+			if err != nil {
+				t.Fatalf("Marshal() error = %v", err)
+			}
+			var back map[string]int
+			if err := json.Unmarshal(data, &back); err != nil {
+				t.Fatalf("Unmarshal() error = %v", err)
+			}
+			if !reflect.DeepEqual(back, tt.want) {
+				t.Errorf("Marshal() = %s, want %v", data, tt.want)
+			}
+		})
+	}
+
+	if data, _ := json.Marshal(BiMap[string, int]{}); string(data) != "{}" {
+		t.Errorf("Marshal(zero) = %s, want {}", data)
+	}
+}
@@ -0,0 +1,179 @@
+package maps
+
+import (
+	"hash/maphash"
+	"runtime"
+	"sync"
+)
+
+// Concurrent is a map safe for concurrent use, split into shards that each have their own RWMutex
+// so goroutines working on different keys rarely contend. The zero value is an empty map ready
+// to use with the default shard count; use NewConcurrent to pick it. A Concurrent must not be copied.
+type Concurrent[K comparable, V any] struct {
+	once   sync.Once
+	seed   maphash.Seed
+	mask   uint64
+	shards []shard[K, V]
+}
+
+type shard[K comparable, V any] struct {
+	mu sync.RWMutex
+	m  map[K]V
+}
+
+// NewConcurrent returns an empty map with at least shards shards, rounded up to a power of two.
+// shards <= 0 picks a default based on GOMAXPROCS.
+func NewConcurrent[K comparable, V any](shards int) *Concurrent[K, V] {
+	c := &Concurrent[K, V]{}
+	c.once.Do(func() { c.setup(shards) })
+	return c
+}
+
+func (c *Concurrent[K, V]) setup(shards int) {
+	if shards <= 0 {
+		shards = 4 * runtime.GOMAXPROCS(0)
+	}
+	n := 1
+	for n < shards {
+		n <<= 1
+	}
+	c.seed = maphash.MakeSeed()
+	c.mask = uint64(n - 1)
+	c.shards = make([]shard[K, V], n)
+	for i := range c.shards {
+		c.shards[i].m = make(map[K]V)
+	}
+}
+
+// lazyInit sets up the shards of a zero value Concurrent on first use.
+func (c *Concurrent[K, V]) lazyInit() {
+	c.once.Do(func() { c.setup(0) })
+}
+
+func (c *Concurrent[K, V]) shard(key K) *shard[K, V] {
+	c.lazyInit()
+	return &c.shards[maphash.Comparable(c.seed, key)&c.mask]
+}
+
+func (c *Concurrent[K, V]) Load(key K) (V, bool) {
+	s := c.shard(key)
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	v, ok := s.m[key]
+	return v, ok
+}
+
+func (c *Concurrent[K, V]) Store(key K, value V) {
+	s := c.shard(key)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.m[key] = value
+}
+
+// LoadOrStore returns the existing value of key if present, otherwise it stores value and returns it.
+// loaded reports whether the value was already present.
+func (c *Concurrent[K, V]) LoadOrStore(key K, value V) (actual V, loaded bool) {
+	s := c.shard(key)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if v, ok := s.m[key]; ok {
+		return v, true
+	}
+	s.m[key] = value
+	return value, false
+}
+
+// Compute atomically replaces the value of key with the one fn returns, or deletes key when
+// fn reports false. fn gets the current value and whether it was present, and runs with the
+// shard locked, so it must not call back into c.
+func (c *Concurrent[K, V]) Compute(key K, fn func(old V, loaded bool) (V, bool)) (V, bool) {
+	s := c.shard(key)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	old, loaded := s.m[key]
+	nv, keep := fn(old, loaded)
+	if keep {
+		s.m[key] = nv
+	} else {
+		delete(s.m, key)
+	}
+	return nv, keep
+}
+
+func (c *Concurrent[K, V]) Delete(key K) {
+	s := c.shard(key)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.m, key)
+}
+
+// Range calls fn for every entry until it returns false. Each shard is copied under its read lock
+// and fn runs unlocked, so fn may modify c, but it sees no single consistent view of the whole map.
+func (c *Concurrent[K, V]) Range(fn func(K, V) bool) {
+	c.lazyInit()
+	for i := range c.shards {
+		for k, v := range c.shards[i].snapshot() {
+			if !fn(k, v) {
+				return
+			}
+		}
+	}
+}
+
+func (c *Concurrent[K, V]) Len() int {
+	c.lazyInit()
+	n := 0
+	for i := range c.shards {
+		s := &c.shards[i]
+		s.mu.RLock()
+		n += len(s.m)
+		s.mu.RUnlock()
+	}
+	return n
+}
+
+// Snapshot copies the entries into a plain map, locking one shard at a time.
+func (c *Concurrent[K, V]) Snapshot() map[K]V {
+	c.lazyInit()
+	ret := make(map[K]V, c.Len())
+	for i := range c.shards {
+		s := &c.shards[i]
+		s.mu.RLock()
+		for k, v := range s.m {
+			ret[k] = v
+		}
+		s.mu.RUnlock()
+	}
+	return ret
+}
+
+func (s *shard[K, V]) snapshot() map[K]V {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	ret := make(map[K]V, len(s.m))
+	for k, v := range s.m {
+		ret[k] = v
+	}
+	return ret
+}
+
+// Filter is Filter applied to a Snapshot of c.
+func (c *Concurrent[K, V]) Filter(fn func(K, V) bool) map[K]V {
+	return Filter(c.Snapshot(), fn)
+}
+
+// ConcurrentMap is Map applied to a Snapshot of c. It is a function because methods cannot
+// take type parameters.
+func ConcurrentMap[K comparable, V any, R any](c *Concurrent[K, V], fn func(K, V) (R, bool)) []R {
+	return Map(c.Snapshot(), fn)
+}
+
+// Every is Every applied to a Snapshot of c.
+func (c *Concurrent[K, V]) Every(fn func(K, V) bool) bool {
+	return Every(c.Snapshot(), fn)
+}
+
+// Some is Some applied to a Snapshot of c.
+func (c *Concurrent[K, V]) Some(fn func(K, V) bool) bool {
+	return Some(c.Snapshot(), fn)
+}
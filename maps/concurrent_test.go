@@ -0,0 +1,231 @@
+package maps
+
+import (
+	"reflect"
+	"sort"
+	"strconv"
+	"sync"
+	"testing"
+)
+
+func TestConcurrent(t *testing.T) {
+	c := NewConcurrent[string, int](3)
+	if got := len(c.shards); got != 4 {
+		t.Errorf("shards = %d, want 4", got)
+	}
+
+	c.Store("a", 1)
+	c.Store("b", 2)
+	if v, ok := c.Load("a"); !ok || v != 1 {
+		t.Errorf("Load(a) = %v, %v, want 1, true", v, ok)
+	}
+	if _, ok := c.Load("z"); ok {
+		t.Errorf("Load(z) found a value")
+	}
+
+	if v, loaded := c.LoadOrStore("a", 10); !loaded || v != 1 {
+		t.Errorf("LoadOrStore(a) = %v, %v, want 1, true", v, loaded)
+	}
+	if v, loaded := c.LoadOrStore("c", 3); loaded || v != 3 {
+		t.Errorf("LoadOrStore(c) = %v, %v, want 3, false", v, loaded)
+	}
+
+	if v, ok := c.Compute("a", func(old int, loaded bool) (int, bool) { return old + 10, true }); !ok || v != 11 {
+		t.Errorf("Compute(a) = %v, %v, want 11, true", v, ok)
+	}
+	c.Compute("b", func(int, bool) (int, bool) { return 0, false })
+	c.Delete("c")
+	c.Delete("z")
+
+	if got, want := c.Snapshot(), map[string]int{"a": 11}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Snapshot() = %v, want %v", got, want)
+	}
+	if c.Len() != 1 {
+		t.Errorf("Len() = %d, want 1", c.Len())
+	}
+}
+
+func TestConcurrent_ZeroValue(t *testing.T) {
+	var c Concurrent[string, int]
+	if c.Len() != 0 || len(c.Snapshot()) != 0 {
+		t.Errorf("zero value is not empty")
+	}
+	if _, ok := c.Load("a"); ok {
+		t.Errorf("Load(a) found a value")
+	}
+
+	var wg sync.WaitGroup
+	for w := 0; w < 4; w++ {
+		wg.Add(1)
+		go func(w int) {
+			defer wg.Done()
+			c.Store(strconv.Itoa(w), w)
+		}(w)
+	}
+	wg.Wait()
+	if got, want := c.Snapshot(), map[string]int{"0": 0, "1": 1, "2": 2, "3": 3}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Snapshot() = %v, want %v", got, want)
+	}
+}
+
+func TestConcurrent_ZeroValueRace(t *testing.T) {
+	// the first calls race to set up the shards
+	var c Concurrent[int, int]
+	var wg sync.WaitGroup
+	for w := 0; w < 8; w++ {
+		wg.Add(1)
+		go func(w int) {
+			defer wg.Done()
+			c.Compute(0, func(old int, _ bool) (int, bool) { return old + 1, true })
+		}(w)
+	}
+	wg.Wait()
+	if v, _ := c.Load(0); v != 8 {
+		t.Errorf("Load(0) = %d, want 8", v)
+	}
+}
+
+func TestConcurrent_Snapshots(t *testing.T) {
+	c := NewConcurrent[int, int](0)
+	for i := 0; i < 100; i++ {
+		c.Store(i, i*i)
+	}
+	isEven := func(k, _ int) bool { return k%2 == 0 }
+
+	if got := c.Filter(isEven); len(got) != 50 || got[10] != 100 {
+		t.Errorf("Filter() = %v", got)
+	}
+	got := ConcurrentMap(c, func(k, v int) (int, bool) { return v, k < 3 })
+	sort.Ints(got)
+	if want := []int{0, 1, 4}; !reflect.DeepEqual(got, want) {
+		t.Errorf("ConcurrentMap() = %v, want %v", got, want)
+	}
+	if !c.Every(func(k, v int) bool { return v == k*k }) {
+		t.Errorf("Every() = false, want true")
+	}
+	if c.Some(func(k, _ int) bool { return k >= 100 }) {
+		t.Errorf("Some() = true, want false")
+	}
+
+	n := 0
+	c.Range(func(int, int) bool {
+		n++
+		return n < 10
+	})
+	if n != 10 {
+		t.Errorf("Range() visited %d entries after stopping, want 10", n)
+	}
+
+	// fn runs unlocked, so Range may modify the map it walks.
+	c.Range(func(k, _ int) bool {
+		c.Delete(k)
+		return true
+	})
+	if c.Len() != 0 {
+		t.Errorf("Len() = %d after deleting in Range, want 0", c.Len())
+	}
+}
+
+func TestConcurrent_Race(t *testing.T) {
+	const workers, keys, rounds = 8, 64, 200
+	c := NewConcurrent[int, int](4)
+
+	var wg sync.WaitGroup
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func(w int) {
+			defer wg.Done()
+			for r := 0; r < rounds; r++ {
+				k := (w + r) % keys
+				c.Compute(k, func(old int, _ bool) (int, bool) { return old + 1, true })
+				c.Load(k)
+				c.LoadOrStore(keys+w, w)
+				c.Store(2*keys+w, r)
+				c.Len()
+				if r%50 == 0 {
+					c.Range(func(int, int) bool { return true })
+					c.Filter(func(int, int) bool { return true })
+				}
+			}
+		}(w)
+	}
+	wg.Wait()
+
+	total := 0
+	for k := 0; k < keys; k++ {
+		v, _ := c.Load(k)
+		total += v
+	}
+	if total != workers*rounds {
+		t.Errorf("Compute total = %d, want %d", total, workers*rounds)
+	}
+	for w := 0; w < workers; w++ {
+		if v, _ := c.Load(keys + w); v != w {
+			t.Errorf("LoadOrStore(%d) = %d, want %d", keys+w, v, w)
+		}
+	}
+}
+
+// benchmarkLoad runs b.N operations over 1024 keys, writing one in every writeEvery.
+func benchmarkLoad(b *testing.B, load func(k int), store func(k int), writeEvery int) {
+	for i := 0; i < 1024; i++ {
+		store(i)
+	}
+	b.ReportAllocs()
+	b.ResetTimer()
+	b.RunParallel(func(pb *testing.PB) {
+		i := 0
+		for pb.Next() {
+			k := i & 1023
+			if i%writeEvery == 0 {
+				store(k)
+			} else {
+				load(k)
+			}
+			i++
+		}
+	})
+}
+
+func BenchmarkConcurrent(b *testing.B) {
+	for _, load := range []struct {
+		name       string
+		writeEvery int
+	}{
+		{name: "ReadHeavy", writeEvery: 10},
+		{name: "WriteHeavy", writeEvery: 2},
+	} {
+		b.Run(load.name+"/Concurrent", func(b *testing.B) {
+			c := NewConcurrent[int, int](0)
+			benchmarkLoad(b, func(k int) { c.Load(k) }, func(k int) { c.Store(k, k) }, load.writeEvery)
+		})
+		b.Run(load.name+"/sync.Map", func(b *testing.B) {
+			var m sync.Map
+			benchmarkLoad(b, func(k int) { m.Load(k) }, func(k int) { m.Store(k, k) }, load.writeEvery)
+		})
+		b.Run(load.name+"/RWMutex", func(b *testing.B) {
+			var mu sync.RWMutex
+			m := make(map[int]int)
+			benchmarkLoad(b, func(k int) {
+				mu.RLock()
+				_ = m[k]
+				mu.RUnlock()
+			}, func(k int) {
+				mu.Lock()
+				m[k] = k
+				mu.Unlock()
+			}, load.writeEvery)
+		})
+	}
+}
+
+func BenchmarkConcurrent_Snapshot(b *testing.B) {
+	c := NewConcurrent[string, int](0)
+	for i := 0; i < 10000; i++ {
+		c.Store(strconv.Itoa(i), i)
+	}
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		c.Snapshot()
+	}
+}
@@ -1,5 +1,8 @@
 package maps
 
+import "github.com/supermekabu/go_utils/slices"
+
+// Filter grows the result with the matching entries only.
 func Filter[K comparable, V any](elms map[K]V, fn func(K, V) bool) map[K]V {
 	ret := make(map[K]V)
 	for k, v := range elms {
@@ -10,24 +13,45 @@ func Filter[K comparable, V any](elms map[K]V, fn func(K, V) bool) map[K]V {
 	return ret
 }
 
+// Map grows the result with the kept values and returns nil when there are none.
+// Use AppendMap with a reused buffer on hot paths.
 func Map[K comparable, V any, R any](elms map[K]V, fn func(K, V) (R, bool)) []R {
-	var ret []R
+	return AppendMap(nil, elms, fn)
+}
+
+// AppendMap appends the values fn reports true for to dst and returns the extended slice.
+// It does not allocate when dst has enough spare capacity.
+func AppendMap[K comparable, V any, R any](dst []R, elms map[K]V, fn func(K, V) (R, bool)) []R {
 	for k, v := range elms {
 		nv, Ok := fn(k, v)
 		if Ok {
-			ret = append(ret, nv)
+			dst = append(dst, nv)
 		}
 	}
+	return dst
+}
+
+func MapValues[K comparable, V any, R any](elms map[K]V, fn func(K, V) R) map[K]R {
+	ret := make(map[K]R, len(elms))
+	for k, v := range elms {
+		ret[k] = fn(k, v)
+	}
 	return ret
 }
 
-func HasKey[K comparable, V any](elms map[K]V, key K) bool {
-	for k := range elms {
-		if k == key {
-			return true
-		}
+// MapKeys re-keys elms with fn. When fn maps several keys to the same new key,
+// which value is kept is unspecified.
+func MapKeys[K comparable, V any, R comparable](elms map[K]V, fn func(K, V) R) map[R]V {
+	ret := make(map[R]V, len(elms))
+	for k, v := range elms {
+		ret[fn(k, v)] = v
 	}
-	return false
+	return ret
+}
+
+func HasKey[K comparable, V any](elms map[K]V, key K) bool {
+	_, ok := elms[key]
+	return ok
 }
 
 func HasValue[K comparable, V comparable](elms map[K]V, key V) bool {
@@ -39,17 +63,49 @@ func HasValue[K comparable, V comparable](elms map[K]V, key V) bool {
 	return false
 }
 
+// Remove returns a copy of elms without key. elms is not modified.
+// Use Delete to remove keys in place instead.
 func Remove[K comparable, V any](elms map[K]V, key K) map[K]V {
-	ret := make(map[K]V, len(elms)-1)
+	return Omit(elms, key)
+}
+
+// Omit returns a copy of elms without the given keys. elms is not modified.
+func Omit[K comparable, V any](elms map[K]V, keys ...K) map[K]V {
+	ret := make(map[K]V, len(elms))
 	for k, v := range elms {
-		if k == key {
-			continue
-		}
 		ret[k] = v
 	}
+	Delete(ret, keys...)
 	return ret
 }
 
+// Pick returns a copy of elms with only the given keys that are present. elms is not modified.
+func Pick[K comparable, V any](elms map[K]V, keys ...K) map[K]V {
+	ret := make(map[K]V, min(len(elms), len(keys)))
+	for _, k := range keys {
+		if v, ok := elms[k]; ok {
+			ret[k] = v
+		}
+	}
+	return ret
+}
+
+// Delete removes the given keys from elms in place.
+func Delete[K comparable, V any](elms map[K]V, keys ...K) {
+	for _, k := range keys {
+		delete(elms, k)
+	}
+}
+
+// DeleteFunc removes every entry fn matches from elms in place.
+func DeleteFunc[K comparable, V any](elms map[K]V, fn func(K, V) bool) {
+	for k, v := range elms {
+		if fn(k, v) {
+			delete(elms, k)
+		}
+	}
+}
+
 func Every[K comparable, V any](elms map[K]V, fn func(K, V) bool) bool {
 	for k, v := range elms {
 		if !fn(k, v) {
@@ -67,3 +123,29 @@ func Some[K comparable, V any](elms map[K]V, fn func(K, V) bool) bool {
 	}
 	return false
 }
+
+func Reduce[K comparable, V any, R any](elms map[K]V, init R, fn func(R, K, V) R) R {
+	acc := init
+	for k, v := range elms {
+		acc = fn(acc, k, v)
+	}
+	return acc
+}
+
+// FromPairs builds a map from key/value pairs. Later pairs overwrite earlier ones with the same key.
+func FromPairs[K comparable, V any](pairs []slices.Pair[K, V]) map[K]V {
+	ret := make(map[K]V, len(pairs))
+	for _, p := range pairs {
+		ret[p.First] = p.Second
+	}
+	return ret
+}
+
+// ToPairs returns the key/value pairs of elms in unspecified order.
+func ToPairs[K comparable, V any](elms map[K]V) []slices.Pair[K, V] {
+	ret := make([]slices.Pair[K, V], 0, len(elms))
+	for k, v := range elms {
+		ret = append(ret, slices.Pair[K, V]{First: k, Second: v})
+	}
+	return ret
+}
@@ -5,6 +5,8 @@ import (
 	"sort"
 	"strconv"
 	"testing"
+
+	"github.com/supermekabu/go_utils/slices"
 )
 
 func TestFilter(t *testing.T) {
@@ -99,6 +101,91 @@ func TestMap(t *testing.T) {
 			if !reflect.DeepEqual(got, tt.want) {
 				t.Errorf("Map() = %v, want %v", got, tt.want)
 			}
+			if got := MapSorted(tt.args.src, tt.args.fn); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("MapSorted() = %v, want %v in key order", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMapValues(t *testing.T) {
+	type args[K comparable, V any, R any] struct {
+		src map[K]V
+		fn  func(K, V) R
+	}
+
+	type test[A comparable, B any, C any] struct {
+		name string
+		args args[A, B, C]
+		want map[A]C
+	}
+
+	tests := []test[string, int, string]{
+		{
+			name: "wants mapped",
+			args: args[string, int, string]{
+				src: map[string]int{"a": 1, "b": 2},
+				fn: func(k string, v int) string {
+					return k + strconv.Itoa(v)
+				},
+			},
+			want: map[string]string{"a": "a1", "b": "b2"},
+		},
+		{
+			name: "wants empty",
+			args: args[string, int, string]{
+				src: map[string]int{},
+				fn: func(k string, v int) string {
+					return k
+				},
+			},
+			want: map[string]string{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := MapValues(tt.args.src, tt.args.fn); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("MapValues() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMapKeys(t *testing.T) {
+	type args[K comparable, V any, R comparable] struct {
+		src map[K]V
+		fn  func(K, V) R
+	}
+
+	type test[A comparable, B any, C comparable] struct {
+		name string
+		args args[A, B, C]
+		want map[C]B
+	}
+
+	tests := []test[string, int, int]{
+		{
+			name: "wants re-keyed",
+			args: args[string, int, int]{
+				src: map[string]int{"1": 10, "2": 20},
+				fn: func(k string, v int) int {
+					p, err := strconv.Atoi(k)
+					if err != nil {
+						t.Fatalf("failed parse int %v", err)
+					}
+					return p * 100
+				},
+			},
+			want: map[int]int{100: 10, 200: 20},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := MapKeys(tt.args.src, tt.args.fn); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("MapKeys() = %v, want %v", got, tt.want)
+			}
 		})
 	}
 }
@@ -212,17 +299,117 @@ func TestRemove(t *testing.T) {
 			},
 			want: map[int]int{1: 1, 2: 2, 3: 3},
 		},
+		{
+			name: "wants empty",
+			args: args[int, int]{
+				src: map[int]int{},
+				tgt: 1,
+			},
+			want: map[int]int{},
+		},
+		{
+			name: "wants empty from nil",
+			args: args[int, int]{
+				src: nil,
+				tgt: 1,
+			},
+			want: map[int]int{},
+		},
 	}
 
 	for _, tt := range stringTests {
 		t.Run(tt.name, func(t *testing.T) {
+			before := len(tt.args.src)
 			if got := Remove(tt.args.src, tt.args.tgt); !reflect.DeepEqual(got, tt.want) {
 				t.Errorf("Remove() = %v, want %v", got, tt.want)
 			}
+			if len(tt.args.src) != before {
+				t.Errorf("Remove() modified input to %v", tt.args.src)
+			}
 		})
 	}
 }
 
+func TestOmitPick(t *testing.T) {
+	type args[K comparable, V any] struct {
+		src  map[K]V
+		keys []K
+	}
+
+	type test[A comparable, B any] struct {
+		name     string
+		args     args[A, B]
+		wantOmit map[A]B
+		wantPick map[A]B
+	}
+
+	tests := []test[string, int]{
+		{
+			name: "wants split",
+			args: args[string, int]{
+				src:  map[string]int{"a": 1, "b": 2, "c": 3},
+				keys: []string{"a", "c", "x"},
+			},
+			wantOmit: map[string]int{"b": 2},
+			wantPick: map[string]int{"a": 1, "c": 3},
+		},
+		{
+			name: "wants no keys",
+			args: args[string, int]{
+				src:  map[string]int{"a": 1},
+				keys: nil,
+			},
+			wantOmit: map[string]int{"a": 1},
+			wantPick: map[string]int{},
+		},
+		{
+			name: "wants empty",
+			args: args[string, int]{
+				src:  map[string]int{},
+				keys: []string{"a"},
+			},
+			wantOmit: map[string]int{},
+			wantPick: map[string]int{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			before := len(tt.args.src)
+			if got := Omit(tt.args.src, tt.args.keys...); !reflect.DeepEqual(got, tt.wantOmit) {
+				t.Errorf("Omit() = %v, want %v", got, tt.wantOmit)
+			}
+			if got := Pick(tt.args.src, tt.args.keys...); !reflect.DeepEqual(got, tt.wantPick) {
+				t.Errorf("Pick() = %v, want %v", got, tt.wantPick)
+			}
+			if len(tt.args.src) != before {
+				t.Errorf("input modified to %v", tt.args.src)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	src := map[string]int{"a": 1, "b": 2, "c": 3}
+	Delete(src, "a", "x", "c")
+	if want := map[string]int{"b": 2}; !reflect.DeepEqual(src, want) {
+		t.Errorf("Delete() left %v, want %v", src, want)
+	}
+
+	var empty map[string]int
+	Delete(empty, "a")
+}
+
+func TestDeleteFunc(t *testing.T) {
+	src := map[string]int{"a": 1, "b": 2, "c": 3, "d": 4}
+	DeleteFunc(src, func(k string, v int) bool {
+		return v%2 == 0
+	})
+	if want := map[string]int{"a": 1, "c": 3}; !reflect.DeepEqual(src, want) {
+		t.Errorf("DeleteFunc() left %v, want %v", src, want)
+	}
+}
+
 func TestEvery(t *testing.T) {
 	type args[K comparable, V any] struct {
 		src map[K]V
@@ -322,3 +509,96 @@ func TestSome(t *testing.T) {
 		})
 	}
 }
+
+func TestReduce(t *testing.T) {
+	type args[K comparable, V any, R any] struct {
+		src  map[K]V
+		init R
+		fn   func(R, K, V) R
+	}
+	type test[A comparable, B any, C any] struct {
+		name string
+		args args[A, B, C]
+		want C
+	}
+
+	sumKeysAndValues := func(acc int, k string, v int) int {
+		p, err := strconv.Atoi(k)
+		if err != nil {
+			t.Fatalf("failed parse int %v", err)
+		}
+		return acc + p + v
+	}
+
+	tests := []test[string, int, int]{
+		{
+			name: "Wants sum",
+			args: args[string, int, int]{
+				src:  map[string]int{"1": 10, "2": 20, "3": 30},
+				init: 0,
+				fn:   sumKeysAndValues,
+			},
+			want: 66,
+		}, {
+			name: "Wants init on empty",
+			args: args[string, int, int]{
+				src:  map[string]int{},
+				init: 5,
+				fn:   sumKeysAndValues,
+			},
+			want: 5,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Reduce(tt.args.src, tt.args.init, tt.args.fn); got != tt.want {
+				t.Errorf("Reduce() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFromPairs(t *testing.T) {
+	type test[A comparable, B any] struct {
+		name  string
+		pairs []slices.Pair[A, B]
+		want  map[A]B
+	}
+
+	tests := []test[string, int]{
+		{
+			name:  "Wants empty",
+			pairs: nil,
+			want:  map[string]int{},
+		}, {
+			name:  "Wants map",
+			pairs: slices.Zip([]string{"a", "b", "a"}, []int{1, 2, 3}),
+			want:  map[string]int{"a": 3, "b": 2},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := FromPairs(tt.pairs); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("FromPairs() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestToPairs(t *testing.T) {
+	src := map[string]int{"a": 1, "b": 2, "c": 3}
+	got := ToPairs(src)
+	sort.Slice(got, func(i, j int) bool {
+		return got[i].First < got[j].First
+	})
+
+	keys, values := slices.Unzip(got)
+	if !reflect.DeepEqual(keys, []string{"a", "b", "c"}) || !reflect.DeepEqual(values, []int{1, 2, 3}) {
+		t.Errorf("ToPairs() = %v", got)
+	}
+	if back := FromPairs(got); !reflect.DeepEqual(back, src) {
+		t.Errorf("FromPairs(ToPairs()) = %v, want %v", back, src)
+	}
+}
@@ -0,0 +1,112 @@
+package maps
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/supermekabu/go_utils/slices"
+)
+
+var ErrConflict = errors.New("conflicting key")
+
+// ConflictFunc resolves a key present in more than one merged map.
+// prev is the value merged so far and next the one from the later map.
+type ConflictFunc[K comparable, V any] func(key K, prev, next V) (V, error)
+
+func FirstWins[K comparable, V any](_ K, prev, _ V) (V, error) {
+	return prev, nil
+}
+
+func LastWins[K comparable, V any](_ K, _, next V) (V, error) {
+	return next, nil
+}
+
+// ErrorOnConflict fails the merge with an error wrapping ErrConflict.
+func ErrorOnConflict[K comparable, V any](key K, prev, _ V) (V, error) {
+	return prev, fmt.Errorf("%w %v", ErrConflict, key)
+}
+
+// ResolveWith turns a conflict function that cannot fail into a ConflictFunc.
+func ResolveWith[K comparable, V any](fn func(key K, prev, next V) V) ConflictFunc[K, V] {
+	return func(key K, prev, next V) (V, error) {
+		return fn(key, prev, next), nil
+	}
+}
+
+// Merge returns a new map with the entries of every map, later maps winning on conflicts.
+func Merge[K comparable, V any](elms ...map[K]V) map[K]V {
+	ret, _ := MergeFunc(LastWins[K, V], elms...)
+	return ret
+}
+
+// MergeFunc returns a new map with the entries of every map, resolving keys present in
+// more than one of them with resolve. The inputs are not modified.
+func MergeFunc[K comparable, V any](resolve ConflictFunc[K, V], elms ...map[K]V) (map[K]V, error) {
+	size := 0
+	for _, m := range elms {
+		size = max(size, len(m))
+	}
+	ret := make(map[K]V, size)
+	for _, m := range elms {
+		for k, v := range m {
+			if prev, ok := ret[k]; ok {
+				resolved, err := resolve(k, prev, v)
+				if err != nil {
+					return nil, err
+				}
+				v = resolved
+			}
+			ret[k] = v
+		}
+	}
+	return ret, nil
+}
+
+// MergeWith merges nested maps key by key, resolving conflicts of the inner maps with resolve.
+// Inner maps of the result are new maps and never alias the inputs.
+func MergeWith[K comparable, K2 comparable, V any](resolve ConflictFunc[K2, V], elms ...map[K]map[K2]V) (map[K]map[K2]V, error) {
+	ret := make(map[K]map[K2]V)
+	for _, m := range elms {
+		for k, inner := range m {
+			merged, err := MergeFunc(resolve, ret[k], inner)
+			if err != nil {
+				return nil, fmt.Errorf("%v: %w", k, err)
+			}
+			ret[k] = merged
+		}
+	}
+	return ret, nil
+}
+
+// Difference describes how a map changed: entries only in the new map, entries only
+// in the old map, and keys present in both whose values differ as old/new pairs.
+type Difference[K comparable, V any] struct {
+	Added   map[K]V
+	Removed map[K]V
+	Changed map[K]slices.Pair[V, V]
+}
+
+func (d Difference[K, V]) IsEmpty() bool {
+	return len(d.Added) == 0 && len(d.Removed) == 0 && len(d.Changed) == 0
+}
+
+// Diff compares before with after, using eq to decide whether values of a shared key are equal.
+func Diff[K comparable, V any](before, after map[K]V, eq func(V, V) bool) Difference[K, V] {
+	d := Difference[K, V]{
+		Added: Filter(after, func(k K, _ V) bool {
+			_, ok := before[k]
+			return !ok
+		}),
+		Removed: Filter(before, func(k K, _ V) bool {
+			_, ok := after[k]
+			return !ok
+		}),
+		Changed: make(map[K]slices.Pair[V, V]),
+	}
+	for k, prev := range before {
+		if next, ok := after[k]; ok && !eq(prev, next) {
+			d.Changed[k] = slices.Pair[V, V]{First: prev, Second: next}
+		}
+	}
+	return d
+}
@@ -0,0 +1,154 @@
+package maps
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/supermekabu/go_utils/slices"
+)
+
+func TestMerge(t *testing.T) {
+	a := map[string]int{"x": 1, "y": 2}
+	b := map[string]int{"y": 20, "z": 30}
+	c := map[string]int{"z": 300}
+
+	tests := []struct {
+		name    string
+		resolve ConflictFunc[string, int]
+		elms    []map[string]int
+		want    map[string]int
+		wantErr error
+	}{
+		{name: "none", resolve: LastWins[string, int], elms: nil, want: map[string]int{}},
+		{name: "last wins", resolve: LastWins[string, int], elms: []map[string]int{a, b, c}, want: map[string]int{"x": 1, "y": 20, "z": 300}},
+		{name: "first wins", resolve: FirstWins[string, int], elms: []map[string]int{a, b, c}, want: map[string]int{"x": 1, "y": 2, "z": 30}},
+		{
+			name: "custom",
+			resolve: ResolveWith(func(_ string, prev, next int) int {
+				return prev + next
+			}),
+			elms: []map[string]int{a, b, c},
+			want: map[string]int{"x": 1, "y": 22, "z": 330},
+		},
+		{name: "error", resolve: ErrorOnConflict[string, int], elms: []map[string]int{a, b}, wantErr: ErrConflict},
+		{name: "no conflict", resolve: ErrorOnConflict[string, int], elms: []map[string]int{a, c}, want: map[string]int{"x": 1, "y": 2, "z": 300}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := MergeFunc(tt.resolve, tt.elms...)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("MergeFunc() error = %v, want %v", err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("MergeFunc() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	t.Run("merge", func(t *testing.T) {
+		if got, want := Merge(a, b, c), (map[string]int{"x": 1, "y": 20, "z": 300}); !reflect.DeepEqual(got, want) {
+			t.Errorf("Merge() = %v, want %v", got, want)
+		}
+	})
+
+	t.Run("inference", func(t *testing.T) {
+		if _, err := MergeFunc(FirstWins, a, b); err != nil {
+			t.Errorf("MergeFunc() error = %v", err)
+		}
+	})
+
+	t.Run("inputs untouched", func(t *testing.T) {
+		if !reflect.DeepEqual(a, map[string]int{"x": 1, "y": 2}) || !reflect.DeepEqual(b, map[string]int{"y": 20, "z": 30}) {
+			t.Errorf("inputs modified: %v, %v", a, b)
+		}
+	})
+
+	t.Run("error message", func(t *testing.T) {
+		_, err := MergeFunc(ErrorOnConflict, a, b)
+		if want := "conflicting key y"; err == nil || err.Error() != want {
+			t.Errorf("MergeFunc() error = %v, want %v", err, want)
+		}
+	})
+}
+
+func TestMergeWith(t *testing.T) {
+	defaults := map[string]map[string]bool{
+		"search":  {"beta": false, "cache": true},
+		"billing": {"beta": false},
+	}
+	overrides := map[string]map[string]bool{
+		"search": {"beta": true},
+		"admin":  {"audit": true},
+	}
+
+	got, err := MergeWith(LastWins, defaults, overrides)
+	if err != nil {
+		t.Fatalf("MergeWith() error = %v", err)
+	}
+	want := map[string]map[string]bool{
+		"search":  {"beta": true, "cache": true},
+		"billing": {"beta": false},
+		"admin":   {"audit": true},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("MergeWith() = %v, want %v", got, want)
+	}
+
+	got["billing"]["beta"] = true
+	if defaults["billing"]["beta"] {
+		t.Errorf("MergeWith() result aliases input inner maps")
+	}
+
+	_, err = MergeWith(ErrorOnConflict, defaults, overrides)
+	if !errors.Is(err, ErrConflict) || err.Error() != "search: conflicting key beta" {
+		t.Errorf("MergeWith() error = %v", err)
+	}
+}
+
+func TestDiff(t *testing.T) {
+	eq := func(a, b int) bool { return a == b }
+
+	tests := []struct {
+		name   string
+		before map[string]int
+		after  map[string]int
+		want   Difference[string, int]
+		empty  bool
+	}{
+		{
+			name:   "changes",
+			before: map[string]int{"a": 1, "b": 2, "c": 3},
+			after:  map[string]int{"b": 2, "c": 30, "d": 4},
+			want: Difference[string, int]{
+				Added:   map[string]int{"d": 4},
+				Removed: map[string]int{"a": 1},
+				Changed: map[string]slices.Pair[int, int]{"c": {First: 3, Second: 30}},
+			},
+		},
+		{
+			name:   "same",
+			before: map[string]int{"a": 1},
+			after:  map[string]int{"a": 1},
+			want: Difference[string, int]{
+				Added:   map[string]int{},
+				Removed: map[string]int{},
+				Changed: map[string]slices.Pair[int, int]{},
+			},
+			empty: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Diff(tt.before, tt.after, eq)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Diff() = %v, want %v", got, tt.want)
+			}
+			if got.IsEmpty() != tt.empty {
+				t.Errorf("IsEmpty() = %v, want %v", got.IsEmpty(), tt.empty)
+			}
+		})
+	}
+}
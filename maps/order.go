@@ -0,0 +1,71 @@
+package maps
+
+import (
+	"cmp"
+	stdslices "slices"
+
+	"github.com/supermekabu/go_utils/slices"
+)
+
+// Keys returns the keys of elms in unspecified order. Use SortedKeys for a stable order.
+func Keys[K comparable, V any](elms map[K]V) []K {
+	ret := make([]K, 0, len(elms))
+	for k := range elms {
+		ret = append(ret, k)
+	}
+	return ret
+}
+
+// Values returns the values of elms in unspecified order.
+// Use Entries to get values in key order.
+func Values[K comparable, V any](elms map[K]V) []V {
+	ret := make([]V, 0, len(elms))
+	for _, v := range elms {
+		ret = append(ret, v)
+	}
+	return ret
+}
+
+// SortedKeys returns the keys of elms in ascending order.
+func SortedKeys[K cmp.Ordered, V any](elms map[K]V) []K {
+	ret := Keys(elms)
+	stdslices.Sort(ret)
+	return ret
+}
+
+// SortedKeysFunc returns the keys of elms ordered by compare.
+func SortedKeysFunc[K comparable, V any](elms map[K]V, compare func(a, b K) int) []K {
+	ret := Keys(elms)
+	stdslices.SortFunc(ret, compare)
+	return ret
+}
+
+// Entries returns the key/value pairs of elms in ascending key order.
+func Entries[K cmp.Ordered, V any](elms map[K]V) []slices.Pair[K, V] {
+	return EntriesFunc(elms, cmp.Compare[K])
+}
+
+// EntriesFunc returns the key/value pairs of elms ordered by compare on their keys.
+func EntriesFunc[K comparable, V any](elms map[K]V, compare func(a, b K) int) []slices.Pair[K, V] {
+	ret := ToPairs(elms)
+	stdslices.SortFunc(ret, func(a, b slices.Pair[K, V]) int {
+		return compare(a.First, b.First)
+	})
+	return ret
+}
+
+// MapSorted is Map applied in ascending key order, so the result order is deterministic.
+func MapSorted[K cmp.Ordered, V any, R any](elms map[K]V, fn func(K, V) (R, bool)) []R {
+	return MapSortedFunc(elms, cmp.Compare[K], fn)
+}
+
+// MapSortedFunc is Map applied in the key order given by compare.
+func MapSortedFunc[K comparable, V any, R any](elms map[K]V, compare func(a, b K) int, fn func(K, V) (R, bool)) []R {
+	var ret []R
+	for _, k := range SortedKeysFunc(elms, compare) {
+		if nv, Ok := fn(k, elms[k]); Ok {
+			ret = append(ret, nv)
+		}
+	}
+	return ret
+}
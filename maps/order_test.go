@@ -0,0 +1,95 @@
+package maps
+
+import (
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/supermekabu/go_utils/slices"
+)
+
+var orderSrc = map[string]int{"b": 2, "C": 3, "a": 1, "d": 4}
+
+func caseInsensitive(a, b string) int {
+	return strings.Compare(strings.ToLower(a), strings.ToLower(b))
+}
+
+func TestKeysValues(t *testing.T) {
+	keys := Keys(orderSrc)
+	sort.Strings(keys)
+	if want := []string{"C", "a", "b", "d"}; !reflect.DeepEqual(keys, want) {
+		t.Errorf("Keys() = %v, want %v", keys, want)
+	}
+
+	values := Values(orderSrc)
+	sort.Ints(values)
+	if want := []int{1, 2, 3, 4}; !reflect.DeepEqual(values, want) {
+		t.Errorf("Values() = %v, want %v", values, want)
+	}
+
+	if got := Keys(map[string]int{}); len(got) != 0 {
+		t.Errorf("Keys() = %v, want empty", got)
+	}
+}
+
+func TestSortedKeys(t *testing.T) {
+	// Repeat to catch results that only look ordered by chance of map iteration.
+	for i := 0; i < 20; i++ {
+		if got, want := SortedKeys(orderSrc), []string{"C", "a", "b", "d"}; !reflect.DeepEqual(got, want) {
+			t.Fatalf("SortedKeys() = %v, want %v", got, want)
+		}
+		if got, want := SortedKeysFunc(orderSrc, caseInsensitive), []string{"a", "b", "C", "d"}; !reflect.DeepEqual(got, want) {
+			t.Fatalf("SortedKeysFunc() = %v, want %v", got, want)
+		}
+	}
+}
+
+func TestEntries(t *testing.T) {
+	type test[K comparable, V any] struct {
+		name string
+		got  []slices.Pair[K, V]
+		want []slices.Pair[K, V]
+	}
+
+	tests := []test[string, int]{
+		{
+			name: "key order",
+			got:  Entries(orderSrc),
+			want: []slices.Pair[string, int]{{First: "C", Second: 3}, {First: "a", Second: 1}, {First: "b", Second: 2}, {First: "d", Second: 4}},
+		},
+		{
+			name: "comparator order",
+			got:  EntriesFunc(orderSrc, caseInsensitive),
+			want: []slices.Pair[string, int]{{First: "a", Second: 1}, {First: "b", Second: 2}, {First: "C", Second: 3}, {First: "d", Second: 4}},
+		},
+		{
+			name: "empty",
+			got:  Entries(map[string]int{}),
+			want: []slices.Pair[string, int]{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if !reflect.DeepEqual(tt.got, tt.want) {
+				t.Errorf("got %v, want %v", tt.got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMapSorted(t *testing.T) {
+	fn := func(k string, v int) (string, bool) {
+		return k + ":" + strings.Repeat("*", v), v != 2
+	}
+
+	for i := 0; i < 20; i++ {
+		if got, want := MapSorted(orderSrc, fn), []string{"C:***", "a:*", "d:****"}; !reflect.DeepEqual(got, want) {
+			t.Fatalf("MapSorted() = %v, want %v", got, want)
+		}
+		if got, want := MapSortedFunc(orderSrc, caseInsensitive, fn), []string{"a:*", "C:***", "d:****"}; !reflect.DeepEqual(got, want) {
+			t.Fatalf("MapSortedFunc() = %v, want %v", got, want)
+		}
+	}
+}
@@ -0,0 +1,6 @@
+//go:build race
+
+package maps
+
+// the race detector changes allocation counts, so exact counts are only checked without it
+const raceEnabled = true
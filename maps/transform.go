@@ -0,0 +1,53 @@
+package maps
+
+import "github.com/supermekabu/go_utils/slices"
+
+// Invert swaps keys and values. When several keys share a value, resolve picks the key to keep.
+// Map iteration order is unspecified, so FirstWins and LastWins keep an arbitrary key; when the
+// result must be deterministic, pick one by its value, e.g.
+// ResolveWith(func(_ V, a, b K) K { return min(a, b) }) for ordered keys.
+func Invert[K comparable, V comparable](elms map[K]V, resolve ConflictFunc[V, K]) (map[V]K, error) {
+	ret := make(map[V]K, len(elms))
+	for k, v := range elms {
+		if prev, ok := ret[v]; ok {
+			resolved, err := resolve(v, prev, k)
+			if err != nil {
+				return nil, err
+			}
+			k = resolved
+		}
+		ret[v] = k
+	}
+	return ret, nil
+}
+
+// InvertMulti maps each value to every key holding it, in unspecified order.
+func InvertMulti[K comparable, V comparable](elms map[K]V) map[V][]K {
+	ret := make(map[V][]K)
+	for k, v := range elms {
+		ret[v] = append(ret[v], k)
+	}
+	return ret
+}
+
+// MapEntries rewrites both key and value of every entry. When fn maps several entries
+// to the same new key, which value is kept is unspecified.
+func MapEntries[K comparable, V any, K2 comparable, V2 any](elms map[K]V, fn func(K, V) (K2, V2)) map[K2]V2 {
+	ret := make(map[K2]V2, len(elms))
+	for k, v := range elms {
+		nk, nv := fn(k, v)
+		ret[nk] = nv
+	}
+	return ret
+}
+
+// FromSlice builds a map from the key/value pair fn returns for each element.
+// Later elements overwrite earlier ones with the same key.
+func FromSlice[T any, K comparable, V any](elms []T, fn func(T) slices.Pair[K, V]) map[K]V {
+	ret := make(map[K]V, len(elms))
+	for _, v := range elms {
+		p := fn(v)
+		ret[p.First] = p.Second
+	}
+	return ret
+}
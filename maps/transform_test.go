@@ -0,0 +1,83 @@
+package maps
+
+import (
+	"errors"
+	"reflect"
+	"sort"
+	"strconv"
+	"testing"
+
+	"github.com/supermekabu/go_utils/slices"
+)
+
+func TestInvert(t *testing.T) {
+	keepSmaller := ResolveWith(func(_ int, prev, next string) string {
+		return min(prev, next)
+	})
+
+	tests := []struct {
+		name    string
+		src     map[string]int
+		resolve ConflictFunc[int, string]
+		want    map[int]string
+		wantErr error
+	}{
+		{name: "empty", src: map[string]int{}, resolve: ErrorOnConflict[int, string], want: map[int]string{}},
+		{name: "unique", src: map[string]int{"a": 1, "b": 2}, resolve: ErrorOnConflict[int, string], want: map[int]string{1: "a", 2: "b"}},
+		{name: "duplicate error", src: map[string]int{"a": 1, "b": 1}, resolve: ErrorOnConflict[int, string], wantErr: ErrConflict},
+		{name: "duplicate resolved", src: map[string]int{"c": 1, "a": 1, "b": 1, "d": 2}, resolve: keepSmaller, want: map[int]string{1: "a", 2: "d"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Invert(tt.src, tt.resolve)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("Invert() error = %v, want %v", err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Invert() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestInvertMulti(t *testing.T) {
+	got := InvertMulti(map[string]int{"a": 1, "b": 2, "c": 1})
+	for _, keys := range got {
+		sort.Strings(keys)
+	}
+	if want := map[int][]string{1: {"a", "c"}, 2: {"b"}}; !reflect.DeepEqual(got, want) {
+		t.Errorf("InvertMulti() = %v, want %v", got, want)
+	}
+}
+
+func TestMapEntries(t *testing.T) {
+	got := MapEntries(map[string]int{"1": 10, "2": 20}, func(k string, v int) (int, string) {
+		p, err := strconv.Atoi(k)
+		if err != nil {
+			t.Fatalf("failed parse int %v", err)
+		}
+		return p, strconv.Itoa(v)
+	})
+	if want := map[int]string{1: "10", 2: "20"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("MapEntries() = %v, want %v", got, want)
+	}
+}
+
+func TestFromSlice(t *testing.T) {
+	type user struct {
+		id   int
+		name string
+	}
+	users := []user{{1, "john"}, {2, "jack"}, {1, "jade"}}
+
+	byID := FromSlice(users, func(u user) slices.Pair[int, string] {
+		return slices.Pair[int, string]{First: u.id, Second: u.name}
+	})
+	if want := map[int]string{1: "jade", 2: "jack"}; !reflect.DeepEqual(byID, want) {
+		t.Errorf("FromSlice() = %v, want %v", byID, want)
+	}
+	if got, want := Entries(byID), []slices.Pair[int, string]{{First: 1, Second: "jade"}, {First: 2, Second: "jack"}}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Entries(FromSlice()) = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,250 @@
+package seqs
+
+import "iter"
+
+// Seq is a lazy sequence of values.
+// It has the same underlying type as iter.Seq, so it can be used in range loops
+// and converted to and from iter.Seq freely.
+// Nothing is evaluated until a terminal step such as Collect, Reduce, First or Count runs.
+type Seq[T any] func(yield func(T) bool)
+
+// Seq2 is a lazy sequence of key/value pairs, compatible with iter.Seq2.
+type Seq2[K any, V any] func(yield func(K, V) bool)
+
+func FromSlice[T any](elms []T) Seq[T] {
+	return func(yield func(T) bool) {
+		for _, v := range elms {
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+func FromMap[K comparable, V any](elms map[K]V) Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for k, v := range elms {
+			if !yield(k, v) {
+				return
+			}
+		}
+	}
+}
+
+// FromChan yields values received from ch until it is closed.
+func FromChan[T any](ch <-chan T) Seq[T] {
+	return func(yield func(T) bool) {
+		for v := range ch {
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// Generate yields values returned by fn until it reports false.
+func Generate[T any](fn func() (T, bool)) Seq[T] {
+	return func(yield func(T) bool) {
+		for {
+			v, ok := fn()
+			if !ok || !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+func (s Seq[T]) Iter() iter.Seq[T] {
+	return iter.Seq[T](s)
+}
+
+func (s Seq[T]) Filter(fn func(T) bool) Seq[T] {
+	return func(yield func(T) bool) {
+		for v := range s {
+			if fn(v) && !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+func (s Seq[T]) Take(n int) Seq[T] {
+	return func(yield func(T) bool) {
+		if n <= 0 {
+			return
+		}
+		i := 0
+		for v := range s {
+			if !yield(v) {
+				return
+			}
+			i++
+			if i >= n {
+				return
+			}
+		}
+	}
+}
+
+func (s Seq[T]) Skip(n int) Seq[T] {
+	return func(yield func(T) bool) {
+		i := 0
+		for v := range s {
+			if i < n {
+				i++
+				continue
+			}
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// Enumerate pairs each value with its index.
+func (s Seq[T]) Enumerate() Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		i := 0
+		for v := range s {
+			if !yield(i, v) {
+				return
+			}
+			i++
+		}
+	}
+}
+
+func (s Seq[T]) Collect() []T {
+	var ret []T
+	for v := range s {
+		ret = append(ret, v)
+	}
+	return ret
+}
+
+// First returns the first value, or false if the sequence is empty.
+func (s Seq[T]) First() (T, bool) {
+	for v := range s {
+		return v, true
+	}
+	var zero T
+	return zero, false
+}
+
+func (s Seq[T]) Count() int {
+	n := 0
+	for range s {
+		n++
+	}
+	return n
+}
+
+func Map[T any, R any](s Seq[T], fn func(T) R) Seq[R] {
+	return func(yield func(R) bool) {
+		for v := range s {
+			if !yield(fn(v)) {
+				return
+			}
+		}
+	}
+}
+
+func FlatMap[T any, R any](s Seq[T], fn func(T) Seq[R]) Seq[R] {
+	return func(yield func(R) bool) {
+		for v := range s {
+			for r := range fn(v) {
+				if !yield(r) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// Chunk yields newly allocated slices of up to size elements.
+// size < 1 yields nothing.
+func Chunk[T any](s Seq[T], size int) Seq[[]T] {
+	return func(yield func([]T) bool) {
+		if size < 1 {
+			return
+		}
+		chunk := make([]T, 0, size)
+		for v := range s {
+			chunk = append(chunk, v)
+			if len(chunk) == size {
+				if !yield(chunk) {
+					return
+				}
+				chunk = make([]T, 0, size)
+			}
+		}
+		if len(chunk) > 0 {
+			yield(chunk)
+		}
+	}
+}
+
+// Zip pairs values of a and b until either of them ends.
+func Zip[A any, B any](a Seq[A], b Seq[B]) Seq2[A, B] {
+	return func(yield func(A, B) bool) {
+		nextB, stop := iter.Pull(iter.Seq[B](b))
+		defer stop()
+		for va := range a {
+			vb, ok := nextB()
+			if !ok || !yield(va, vb) {
+				return
+			}
+		}
+	}
+}
+
+func Reduce[T any, R any](s Seq[T], init R, fn func(R, T) R) R {
+	acc := init
+	for v := range s {
+		acc = fn(acc, v)
+	}
+	return acc
+}
+
+func (s Seq2[K, V]) Iter() iter.Seq2[K, V] {
+	return iter.Seq2[K, V](s)
+}
+
+func (s Seq2[K, V]) Filter(fn func(K, V) bool) Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for k, v := range s {
+			if fn(k, v) && !yield(k, v) {
+				return
+			}
+		}
+	}
+}
+
+func (s Seq2[K, V]) Keys() Seq[K] {
+	return func(yield func(K) bool) {
+		for k := range s {
+			if !yield(k) {
+				return
+			}
+		}
+	}
+}
+
+func (s Seq2[K, V]) Values() Seq[V] {
+	return func(yield func(V) bool) {
+		for _, v := range s {
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// CollectMap builds a map from s. Later pairs overwrite earlier ones with the same key.
+func CollectMap[K comparable, V any](s Seq2[K, V]) map[K]V {
+	ret := make(map[K]V)
+	for k, v := range s {
+		ret[k] = v
+	}
+	return ret
+}
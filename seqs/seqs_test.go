@@ -0,0 +1,222 @@
+package seqs
+
+import (
+	"iter"
+	"reflect"
+	"sort"
+	"strconv"
+	"testing"
+)
+
+func TestLazy(t *testing.T) {
+	calls := 0
+	s := Chunk(Map(FromSlice([]int{1, 2, 3, 4, 5, 6}).Filter(func(v int) bool {
+		calls++
+		return v%2 == 0
+	}), func(v int) string {
+		calls++
+		return strconv.Itoa(v)
+	}), 2)
+
+	t.Run("no work before terminal step", func(t *testing.T) {
+		if calls != 0 {
+			t.Errorf("callbacks called %d times before Collect", calls)
+		}
+	})
+
+	t.Run("collect", func(t *testing.T) {
+		want := [][]string{{"2", "4"}, {"6"}}
+		if got := s.Collect(); !reflect.DeepEqual(got, want) {
+			t.Errorf("Collect() = %v, want %v", got, want)
+		}
+		if calls != 9 {
+			t.Errorf("callbacks called %d times, want 9", calls)
+		}
+	})
+
+	t.Run("stops early", func(t *testing.T) {
+		calls = 0
+		if got, ok := s.First(); !ok || !reflect.DeepEqual(got, []string{"2", "4"}) {
+			t.Errorf("First() = %v, %v", got, ok)
+		}
+		if calls != 6 {
+			t.Errorf("callbacks called %d times, want 6", calls)
+		}
+	})
+}
+
+func TestSeq(t *testing.T) {
+	type test[T any] struct {
+		name string
+		seq  Seq[T]
+		want []T
+	}
+
+	src := []int{1, 2, 3, 4, 5}
+	tests := []test[int]{
+		{name: "from slice", seq: FromSlice(src), want: src},
+		{name: "from empty slice", seq: FromSlice([]int{}), want: nil},
+		{name: "filter", seq: FromSlice(src).Filter(func(v int) bool { return v > 2 }), want: []int{3, 4, 5}},
+		{name: "take", seq: FromSlice(src).Take(2), want: []int{1, 2}},
+		{name: "take zero", seq: FromSlice(src).Take(0), want: nil},
+		{name: "take more", seq: FromSlice(src).Take(10), want: src},
+		{name: "skip", seq: FromSlice(src).Skip(3), want: []int{4, 5}},
+		{name: "skip more", seq: FromSlice(src).Skip(10), want: nil},
+		{name: "skip and take", seq: FromSlice(src).Skip(1).Take(3), want: []int{2, 3, 4}},
+		{name: "map", seq: Map(FromSlice(src), func(v int) int { return v * v }), want: []int{1, 4, 9, 16, 25}},
+		{
+			name: "flat map",
+			seq: FlatMap(FromSlice([]int{1, 2, 3}), func(v int) Seq[int] {
+				return FromSlice([]int{v, v * 10})
+			}),
+			want: []int{1, 10, 2, 20, 3, 30},
+		},
+		{
+			name: "flat map take",
+			seq: FlatMap(FromSlice([]int{1, 2, 3}), func(v int) Seq[int] {
+				return FromSlice([]int{v, v * 10})
+			}).Take(3),
+			want: []int{1, 10, 2},
+		},
+		{
+			name: "generate",
+			seq: Generate(func() func() (int, bool) {
+				i := 0
+				return func() (int, bool) {
+					i++
+					return i, i <= 3
+				}
+			}()),
+			want: []int{1, 2, 3},
+		},
+		{name: "generate infinite", seq: Generate(func() (int, bool) { return 7, true }).Take(2), want: []int{7, 7}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.seq.Collect(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Collect() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestChunk(t *testing.T) {
+	tests := []struct {
+		name string
+		src  []int
+		size int
+		want [][]int
+	}{
+		{name: "remainder", src: []int{1, 2, 3, 4, 5}, size: 2, want: [][]int{{1, 2}, {3, 4}, {5}}},
+		{name: "even", src: []int{1, 2, 3, 4}, size: 2, want: [][]int{{1, 2}, {3, 4}}},
+		{name: "empty", src: []int{}, size: 2, want: nil},
+		{name: "zero size", src: []int{1, 2}, size: 0, want: nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Chunk(FromSlice(tt.src), tt.size).Collect(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Chunk() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSeq_First(t *testing.T) {
+	if v, ok := FromSlice([]int{}).First(); ok || v != 0 {
+		t.Errorf("First() = %v, %v, want 0, false", v, ok)
+	}
+	if v, ok := FromSlice([]int{3, 4}).First(); !ok || v != 3 {
+		t.Errorf("First() = %v, %v, want 3, true", v, ok)
+	}
+}
+
+func TestSeq_Count(t *testing.T) {
+	if got := FromSlice([]int{}).Count(); got != 0 {
+		t.Errorf("Count() = %v, want 0", got)
+	}
+	if got := FromSlice([]int{1, 2, 3}).Filter(func(v int) bool { return v > 1 }).Count(); got != 2 {
+		t.Errorf("Count() = %v, want 2", got)
+	}
+}
+
+func TestFromChan(t *testing.T) {
+	ch := make(chan int)
+	go func() {
+		defer close(ch)
+		for i := 1; i <= 3; i++ {
+			ch <- i
+		}
+	}()
+
+	if got := FromChan(ch).Collect(); !reflect.DeepEqual(got, []int{1, 2, 3}) {
+		t.Errorf("FromChan() = %v, want %v", got, []int{1, 2, 3})
+	}
+}
+
+func TestZip(t *testing.T) {
+	keys := FromSlice([]string{"a", "b", "c"})
+	values := FromSlice([]int{1, 2})
+
+	want := map[string]int{"a": 1, "b": 2}
+	if got := CollectMap(Zip(keys, values)); !reflect.DeepEqual(got, want) {
+		t.Errorf("Zip() = %v, want %v", got, want)
+	}
+}
+
+func TestReduce(t *testing.T) {
+	sum := func(acc, v int) int { return acc + v }
+	if got := Reduce(FromSlice([]int{}), 10, sum); got != 10 {
+		t.Errorf("Reduce() = %v, want 10", got)
+	}
+	if got := Reduce(FromSlice([]int{1, 2, 3}), 0, sum); got != 6 {
+		t.Errorf("Reduce() = %v, want 6", got)
+	}
+}
+
+func TestSeq2(t *testing.T) {
+	src := map[string]int{"a": 1, "b": 2, "c": 3}
+	s := FromMap(src).Filter(func(k string, v int) bool { return v > 1 })
+
+	keys := s.Keys().Collect()
+	sort.Strings(keys)
+	if want := []string{"b", "c"}; !reflect.DeepEqual(keys, want) {
+		t.Errorf("Keys() = %v, want %v", keys, want)
+	}
+
+	values := s.Values().Collect()
+	sort.Ints(values)
+	if want := []int{2, 3}; !reflect.DeepEqual(values, want) {
+		t.Errorf("Values() = %v, want %v", values, want)
+	}
+
+	if got, want := CollectMap(s), map[string]int{"b": 2, "c": 3}; !reflect.DeepEqual(got, want) {
+		t.Errorf("CollectMap() = %v, want %v", got, want)
+	}
+}
+
+func TestRangeOverFunc(t *testing.T) {
+	var got []string
+	for i, v := range FromSlice([]string{"a", "b", "c"}).Enumerate() {
+		if i == 2 {
+			break
+		}
+		got = append(got, strconv.Itoa(i)+v)
+	}
+	if want := []string{"0a", "1b"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("range = %v, want %v", got, want)
+	}
+
+	var std iter.Seq[int] = FromSlice([]int{1, 2}).Iter()
+	if got := Seq[int](std).Count(); got != 2 {
+		t.Errorf("Count() = %v, want 2", got)
+	}
+
+	var std2 iter.Seq2[string, int] = FromMap(map[string]int{"a": 1}).Iter()
+	for k, v := range std2 {
+		if k != "a" || v != 1 {
+			t.Errorf("range = %v, %v, want a, 1", k, v)
+		}
+	}
+}
@@ -0,0 +1,182 @@
+package sets
+
+import (
+	"bytes"
+	"cmp"
+	"encoding/json"
+	"slices"
+)
+
+// Set is an unordered collection of unique values backed by a map.
+// The zero value is a nil map, so use New or make before adding to it.
+type Set[T comparable] map[T]struct{}
+
+func New[T comparable](elms ...T) Set[T] {
+	return FromSlice(elms)
+}
+
+func FromSlice[T comparable](elms []T) Set[T] {
+	ret := make(Set[T], len(elms))
+	ret.Add(elms...)
+	return ret
+}
+
+func FromMapKeys[K comparable, V any](elms map[K]V) Set[K] {
+	ret := make(Set[K], len(elms))
+	for k := range elms {
+		ret[k] = struct{}{}
+	}
+	return ret
+}
+
+func FromMapValues[K comparable, V comparable](elms map[K]V) Set[V] {
+	ret := make(Set[V], len(elms))
+	for _, v := range elms {
+		ret[v] = struct{}{}
+	}
+	return ret
+}
+
+func (s Set[T]) Add(elms ...T) {
+	for _, v := range elms {
+		s[v] = struct{}{}
+	}
+}
+
+func (s Set[T]) Remove(elms ...T) {
+	for _, v := range elms {
+		delete(s, v)
+	}
+}
+
+func (s Set[T]) Has(v T) bool {
+	_, ok := s[v]
+	return ok
+}
+
+func (s Set[T]) Len() int {
+	return len(s)
+}
+
+func (s Set[T]) Clone() Set[T] {
+	ret := make(Set[T], len(s))
+	for v := range s {
+		ret[v] = struct{}{}
+	}
+	return ret
+}
+
+func (s Set[T]) Union(other Set[T]) Set[T] {
+	ret := s.Clone()
+	for v := range other {
+		ret[v] = struct{}{}
+	}
+	return ret
+}
+
+func (s Set[T]) Intersection(other Set[T]) Set[T] {
+	small, large := s, other
+	if len(small) > len(large) {
+		small, large = large, small
+	}
+	ret := make(Set[T])
+	for v := range small {
+		if large.Has(v) {
+			ret[v] = struct{}{}
+		}
+	}
+	return ret
+}
+
+// Difference returns values in s that are not in other.
+func (s Set[T]) Difference(other Set[T]) Set[T] {
+	ret := make(Set[T])
+	for v := range s {
+		if !other.Has(v) {
+			ret[v] = struct{}{}
+		}
+	}
+	return ret
+}
+
+// SymmetricDifference returns values in exactly one of s and other.
+func (s Set[T]) SymmetricDifference(other Set[T]) Set[T] {
+	ret := s.Difference(other)
+	for v := range other {
+		if !s.Has(v) {
+			ret[v] = struct{}{}
+		}
+	}
+	return ret
+}
+
+// IsSubset reports whether every value of s is in other.
+func (s Set[T]) IsSubset(other Set[T]) bool {
+	if len(s) > len(other) {
+		return false
+	}
+	for v := range s {
+		if !other.Has(v) {
+			return false
+		}
+	}
+	return true
+}
+
+func (s Set[T]) Equal(other Set[T]) bool {
+	return len(s) == len(other) && s.IsSubset(other)
+}
+
+// ToSlice returns the values in unspecified order.
+func (s Set[T]) ToSlice() []T {
+	ret := make([]T, 0, len(s))
+	for v := range s {
+		ret = append(ret, v)
+	}
+	return ret
+}
+
+// Sorted returns the values of s in ascending order.
+func Sorted[T cmp.Ordered](s Set[T]) []T {
+	ret := s.ToSlice()
+	slices.Sort(ret)
+	return ret
+}
+
+// SortedFunc returns the values of s ordered by compare.
+func SortedFunc[T comparable](s Set[T], compare func(a, b T) int) []T {
+	ret := s.ToSlice()
+	slices.SortFunc(ret, compare)
+	return ret
+}
+
+// MarshalJSON encodes s as a JSON array ordered by the encoded bytes of each value,
+// so equal sets always encode the same. For numbers this is not numeric order,
+// encode Sorted(s) instead when that matters.
+func (s Set[T]) MarshalJSON() ([]byte, error) {
+	elms := make([][]byte, 0, len(s))
+	for v := range s {
+		data, err := json.Marshal(v)
+		if err != nil {
+			return nil, err
+		}
+		elms = append(elms, data)
+	}
+	slices.SortFunc(elms, bytes.Compare)
+
+	var buf bytes.Buffer
+	buf.WriteByte('[')
+	buf.Write(bytes.Join(elms, []byte{','}))
+	buf.WriteByte(']')
+	return buf.Bytes(), nil
+}
+
+// UnmarshalJSON decodes a JSON array, dropping duplicate values.
+func (s *Set[T]) UnmarshalJSON(data []byte) error {
+	var elms []T
+	if err := json.Unmarshal(data, &elms); err != nil {
+		return err
+	}
+	*s = FromSlice(elms)
+	return nil
+}
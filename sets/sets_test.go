@@ -0,0 +1,168 @@
+package sets
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/supermekabu/go_utils/maps"
+	"github.com/supermekabu/go_utils/slices"
+)
+
+func TestSet_AddRemoveHas(t *testing.T) {
+	s := New(1, 2, 2, 3)
+	if s.Len() != 3 {
+		t.Errorf("Len() = %v, want 3", s.Len())
+	}
+
+	s.Add(4, 1)
+	s.Remove(2, 5)
+	if want := []int{1, 3, 4}; !reflect.DeepEqual(Sorted(s), want) {
+		t.Errorf("Sorted() = %v, want %v", Sorted(s), want)
+	}
+	if !s.Has(3) || s.Has(2) {
+		t.Errorf("Has() = %v, %v, want true, false", s.Has(3), s.Has(2))
+	}
+
+	var empty Set[int]
+	if empty.Has(1) || empty.Len() != 0 {
+		t.Errorf("nil set Has() = %v, Len() = %v", empty.Has(1), empty.Len())
+	}
+}
+
+func TestSet_Algebra(t *testing.T) {
+	a := New(1, 2, 3, 4)
+	b := New(3, 4, 5)
+
+	tests := []struct {
+		name string
+		got  Set[int]
+		want []int
+	}{
+		{name: "union", got: a.Union(b), want: []int{1, 2, 3, 4, 5}},
+		{name: "intersection", got: a.Intersection(b), want: []int{3, 4}},
+		{name: "difference", got: a.Difference(b), want: []int{1, 2}},
+		{name: "difference reversed", got: b.Difference(a), want: []int{5}},
+		{name: "symmetric difference", got: a.SymmetricDifference(b), want: []int{1, 2, 5}},
+		{name: "union with empty", got: a.Union(New[int]()), want: []int{1, 2, 3, 4}},
+		{name: "intersection with empty", got: a.Intersection(nil), want: []int{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Sorted(tt.got); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	t.Run("inputs untouched", func(t *testing.T) {
+		if !a.Equal(New(1, 2, 3, 4)) || !b.Equal(New(3, 4, 5)) {
+			t.Errorf("inputs modified: %v, %v", a, b)
+		}
+	})
+}
+
+func TestSet_IsSubsetEqual(t *testing.T) {
+	tests := []struct {
+		name       string
+		a, b       Set[string]
+		wantSubset bool
+		wantEqual  bool
+	}{
+		{name: "empty", a: New[string](), b: nil, wantSubset: true, wantEqual: true},
+		{name: "subset", a: New("a"), b: New("a", "b"), wantSubset: true, wantEqual: false},
+		{name: "superset", a: New("a", "b"), b: New("a"), wantSubset: false, wantEqual: false},
+		{name: "equal", a: New("a", "b"), b: New("b", "a"), wantSubset: true, wantEqual: true},
+		{name: "disjoint", a: New("a"), b: New("b"), wantSubset: false, wantEqual: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.a.IsSubset(tt.b); got != tt.wantSubset {
+				t.Errorf("IsSubset() = %v, want %v", got, tt.wantSubset)
+			}
+			if got := tt.a.Equal(tt.b); got != tt.wantEqual {
+				t.Errorf("Equal() = %v, want %v", got, tt.wantEqual)
+			}
+		})
+	}
+}
+
+func TestSortedFunc(t *testing.T) {
+	s := New("b", "A", "c")
+	got := SortedFunc(s, func(a, b string) int {
+		return strings.Compare(strings.ToLower(a), strings.ToLower(b))
+	})
+	if want := []string{"A", "b", "c"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("SortedFunc() = %v, want %v", got, want)
+	}
+}
+
+func TestConversions(t *testing.T) {
+	src := []int{1, 2, 3, 4, 5, 6}
+
+	t.Run("from slices", func(t *testing.T) {
+		s := FromSlice(slices.Filter(src, func(v int) bool { return v%2 == 0 }))
+		if !s.Equal(New(2, 4, 6)) {
+			t.Errorf("FromSlice() = %v", s)
+		}
+	})
+
+	t.Run("to slices", func(t *testing.T) {
+		if !slices.Every(New(2, 4).ToSlice(), func(v int) bool { return v%2 == 0 }) {
+			t.Errorf("ToSlice() contains odd values")
+		}
+	})
+
+	t.Run("from maps", func(t *testing.T) {
+		m := maps.Filter(map[string]int{"a": 1, "b": 2, "c": 2}, func(k string, v int) bool { return v == 2 })
+		if keys := FromMapKeys(m); !keys.Equal(New("b", "c")) {
+			t.Errorf("FromMapKeys() = %v", keys)
+		}
+		if values := FromMapValues(m); !values.Equal(New(2)) {
+			t.Errorf("FromMapValues() = %v", values)
+		}
+	})
+
+	t.Run("to maps", func(t *testing.T) {
+		if !maps.HasKey(New("a", "b"), "b") {
+			t.Errorf("HasKey() = false, want true")
+		}
+	})
+}
+
+func TestSet_JSON(t *testing.T) {
+	data, err := json.Marshal(New(3, 1, 2, 10))
+	if err != nil {
+		t.Fatalf("Marshal() error = %v", err)
+	}
+	var arr []int
+	if err := json.Unmarshal(data, &arr); err != nil {
+		t.Fatalf("not encoded as an array: %s", data)
+	}
+	if want := []int{1, 10, 2, 3}; !reflect.DeepEqual(arr, want) {
+		t.Errorf("Marshal() = %s, want %v", data, want)
+	}
+	for i := 0; i < 10; i++ {
+		if again, _ := json.Marshal(New(3, 1, 2, 10)); string(again) != string(data) {
+			t.Fatalf("Marshal() = %s, then %s", data, again)
+		}
+	}
+	if data, _ := json.Marshal(Set[string]{}); string(data) != "[]" {
+		t.Errorf("Marshal(empty) = %s, want []", data)
+	}
+
+	var s Set[int]
+	if err := json.Unmarshal([]byte(`[1, 2, 2, 3]`), &s); err != nil {
+		t.Fatalf("Unmarshal() error = %v", err)
+	}
+	if !s.Equal(New(1, 2, 3)) {
+		t.Errorf("Unmarshal() = %v", s)
+	}
+
+	if err := json.Unmarshal([]byte(`{"a": 1}`), &s); err == nil {
+		t.Errorf("Unmarshal() from object error = nil")
+	}
+}
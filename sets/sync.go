@@ -0,0 +1,66 @@
+package sets
+
+import (
+	"encoding/json"
+	"sync"
+)
+
+// SyncSet is a Set guarded by a RWMutex, safe for concurrent use.
+// The zero value is an empty set ready to use.
+type SyncSet[T comparable] struct {
+	mu  sync.RWMutex
+	set Set[T]
+}
+
+func NewSync[T comparable](elms ...T) *SyncSet[T] {
+	return &SyncSet[T]{set: New(elms...)}
+}
+
+func (s *SyncSet[T]) Add(elms ...T) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.set == nil {
+		s.set = make(Set[T])
+	}
+	s.set.Add(elms...)
+}
+
+func (s *SyncSet[T]) Remove(elms ...T) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.set.Remove(elms...)
+}
+
+func (s *SyncSet[T]) Has(v T) bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.set.Has(v)
+}
+
+func (s *SyncSet[T]) Len() int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.set.Len()
+}
+
+// Snapshot returns a copy of the current values that can be used without locking.
+func (s *SyncSet[T]) Snapshot() Set[T] {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.set.Clone()
+}
+
+func (s *SyncSet[T]) MarshalJSON() ([]byte, error) {
+	return s.Snapshot().MarshalJSON()
+}
+
+func (s *SyncSet[T]) UnmarshalJSON(data []byte) error {
+	var set Set[T]
+	if err := json.Unmarshal(data, &set); err != nil {
+		return err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.set = set
+	return nil
+}
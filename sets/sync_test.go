@@ -0,0 +1,52 @@
+package sets
+
+import (
+	"encoding/json"
+	"sync"
+	"testing"
+)
+
+func TestSyncSet(t *testing.T) {
+	var s SyncSet[int]
+	var wg sync.WaitGroup
+	for g := 0; g < 8; g++ {
+		wg.Add(1)
+		go func(g int) {
+			defer wg.Done()
+			for i := 0; i < 1000; i++ {
+				s.Add(i)
+				s.Has(i)
+				if i%2 == 1 {
+					s.Remove(i)
+				}
+			}
+		}(g)
+	}
+	wg.Wait()
+
+	snapshot := s.Snapshot()
+	if s.Len() != 500 || snapshot.Has(1) || !snapshot.Has(998) {
+		t.Errorf("Len() = %v, want 500 even values", s.Len())
+	}
+
+	snapshot.Add(1)
+	if s.Has(1) {
+		t.Errorf("Snapshot() shares storage with the set")
+	}
+}
+
+func TestSyncSet_JSON(t *testing.T) {
+	s := NewSync("a", "b")
+	data, err := json.Marshal(s)
+	if err != nil {
+		t.Fatalf("Marshal() error = %v", err)
+	}
+
+	got := NewSync[string]()
+	if err := json.Unmarshal(data, got); err != nil {
+		t.Fatalf("Unmarshal() error = %v", err)
+	}
+	if !got.Snapshot().Equal(New("a", "b")) {
+		t.Errorf("round trip = %v", got.Snapshot())
+	}
+}
@@ -0,0 +1,114 @@
+package slices
+
+import (
+	"strconv"
+	"testing"
+)
+
+var (
+	allocSrc    = parallelInput(1000)
+	allocIsEven = func(v int) bool { return v%2 == 0 }
+	allocSparse = func(v int) bool { return v%100 == 0 }
+	allocNone   = func(v int) bool { return false }
+	allocDouble = func(v int) int { return v * 2 }
+	allocHalf   = func(v int) (int, bool) { return v / 2, v%100 == 0 }
+
+	// results are stored in sinks so escape analysis cannot keep them on the stack
+	sinkInts   []int
+	sinkChunks [][]int
+)
+
+func TestAllocs(t *testing.T) {
+	if raceEnabled {
+		t.Skip("allocation counts differ under the race detector")
+	}
+	buf := make([]int, 0, len(allocSrc))
+
+	tests := []struct {
+		name string
+		fn   func()
+		want float64
+	}{
+		// filters allocate len(elms) once, and copy the matches into an exact fit
+		// when fewer than half match
+		{name: "Filter/dense", fn: func() { sinkInts = Filter(allocSrc, allocIsEven) }, want: 1},
+		{name: "Filter/sparse", fn: func() { sinkInts = Filter(allocSrc, allocSparse) }, want: 2},
+		{name: "Filter/none", fn: func() { sinkInts = Filter(allocSrc, allocNone) }, want: 1},
+		{name: "FilterIndexed/sparse", fn: func() { sinkInts = FilterIndexed(allocSrc, func(i, v int) bool { return i%100 == 0 }) }, want: 2},
+		{name: "FilterMap/sparse", fn: func() { sinkInts = FilterMap(allocSrc, allocHalf) }, want: 2},
+		{name: "FilterMap/none", fn: func() { sinkInts = FilterMap(allocSrc, func(v int) (int, bool) { return v, false }) }, want: 1},
+		{name: "Map", fn: func() { sinkInts = Map(allocSrc, allocDouble) }, want: 1},
+		{name: "MapIndexed", fn: func() { sinkInts = MapIndexed(allocSrc, func(i, v int) int { return i + v }) }, want: 1},
+		{name: "AppendFilter", fn: func() { sinkInts = AppendFilter(buf[:0], allocSrc, allocIsEven) }, want: 0},
+		{name: "AppendMap", fn: func() { sinkInts = AppendMap(buf[:0], allocSrc, allocDouble) }, want: 0},
+		{name: "MapInto", fn: func() { sinkInts = MapInto(buf, allocSrc, allocDouble) }, want: 0},
+		{name: "Includes", fn: func() { Includes(allocSrc, 999) }, want: 0},
+		{name: "Every", fn: func() { Every(allocSrc, allocIsEven) }, want: 0},
+		{name: "Some", fn: func() { Some(allocSrc, allocIsEven) }, want: 0},
+		{name: "Chunk", fn: func() { sinkChunks = Chunk(allocSrc, 10) }, want: 1},
+		{name: "Windows", fn: func() { sinkChunks = Windows(allocSrc, 10, 5) }, want: 1},
+		{name: "SplitN", fn: func() { sinkChunks = SplitN(allocSrc, 7) }, want: 1},
+		{name: "RemoveFirst", fn: func() { sinkInts = RemoveFirst(append(buf[:0], allocSrc...), 500) }, want: 0},
+		{name: "RemoveAll", fn: func() { sinkInts = RemoveAll(allocSrc, 500) }, want: 1},
+		{name: "RemoveFunc", fn: func() { sinkInts = RemoveFunc(buf[:0], allocIsEven) }, want: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := testing.AllocsPerRun(100, tt.fn); got != tt.want {
+				t.Errorf("%s allocs = %v, want %v", tt.name, got, tt.want)
+			}
+		})
+	}
+}
+
+// dense filters keep every other element, sparse ones keep 1 in 100
+func BenchmarkFilterMap(b *testing.B) {
+	evenHalf := func(v int) (int, bool) { return v / 2, v%2 == 0 }
+	for _, n := range []int{10, 1000, 100000} {
+		src := parallelInput(n)
+		buf := make([]int, 0, n)
+		b.Run("Filter/dense/n="+strconv.Itoa(n), func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				sinkInts = Filter(src, allocIsEven)
+			}
+		})
+		b.Run("Filter/sparse/n="+strconv.Itoa(n), func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				sinkInts = Filter(src, allocSparse)
+			}
+		})
+		b.Run("AppendFilter/n="+strconv.Itoa(n), func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				buf = AppendFilter(buf[:0], src, allocIsEven)
+			}
+		})
+		b.Run("Map/n="+strconv.Itoa(n), func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				sinkInts = Map(src, allocDouble)
+			}
+		})
+		b.Run("AppendMap/n="+strconv.Itoa(n), func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				buf = AppendMap(buf[:0], src, allocDouble)
+			}
+		})
+		b.Run("FilterMap/dense/n="+strconv.Itoa(n), func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				sinkInts = FilterMap(src, evenHalf)
+			}
+		})
+		b.Run("FilterMap/sparse/n="+strconv.Itoa(n), func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				sinkInts = FilterMap(src, allocHalf)
+			}
+		})
+	}
+}
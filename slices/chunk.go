@@ -0,0 +1,87 @@
+package slices
+
+// Chunk splits org into chunks of chunkSize elements, the last one holding the remainder.
+// Chunks share the backing array with org but have their capacity clipped,
+// so appending to one chunk never overwrites the next. Use ChunkCopy for independent chunks.
+// It returns nil when org is empty or chunkSize < 1.
+func Chunk[T any](org []T, chunkSize int) [][]T {
+	if chunkSize < 1 || len(org) == 0 {
+		return nil
+	}
+
+	chunked := make([][]T, 0, (len(org)+chunkSize-1)/chunkSize)
+	for head := 0; head < len(org); head += chunkSize {
+		tail := head + chunkSize
+		if tail > len(org) {
+			tail = len(org)
+		}
+		chunked = append(chunked, org[head:tail:tail])
+	}
+	return chunked
+}
+
+// ChunkCopy is Chunk over a copy of org, so the chunks never alias org.
+func ChunkCopy[T any](org []T, chunkSize int) [][]T {
+	if chunkSize < 1 || len(org) == 0 {
+		return nil
+	}
+	return Chunk(append([]T(nil), org...), chunkSize)
+}
+
+// Windows returns every window of size elements, starting step elements apart.
+// Only full windows are returned. Windows share the backing array with org
+// and have their capacity clipped.
+// It returns nil when org is shorter than size, or size or step is < 1.
+func Windows[T any](org []T, size, step int) [][]T {
+	if size < 1 || step < 1 || len(org) < size {
+		return nil
+	}
+
+	windows := make([][]T, 0, (len(org)-size)/step+1)
+	for head := 0; head+size <= len(org); head += step {
+		windows = append(windows, org[head:head+size:head+size])
+	}
+	return windows
+}
+
+// SplitN splits org into exactly n parts whose lengths differ by at most one,
+// longer parts first. Parts are empty when n > len(org).
+// Parts share the backing array with org and have their capacity clipped.
+// It returns nil when n < 1.
+func SplitN[T any](org []T, n int) [][]T {
+	if n < 1 {
+		return nil
+	}
+
+	parts := make([][]T, n)
+	size, rest := len(org)/n, len(org)%n
+	head := 0
+	for i := range parts {
+		tail := head + size
+		if i < rest {
+			tail++
+		}
+		parts[i] = org[head:tail:tail]
+		head = tail
+	}
+	return parts
+}
+
+// SplitWhen starts a new chunk whenever fn reports true for a pair of adjacent elements.
+// Chunks share the backing array with org and have their capacity clipped.
+// It returns nil when org is empty.
+func SplitWhen[T any](org []T, fn func(prev, next T) bool) [][]T {
+	if len(org) == 0 {
+		return nil
+	}
+
+	var chunked [][]T
+	head := 0
+	for i := 1; i < len(org); i++ {
+		if fn(org[i-1], org[i]) {
+			chunked = append(chunked, org[head:i:i])
+			head = i
+		}
+	}
+	return append(chunked, org[head:len(org):len(org)])
+}
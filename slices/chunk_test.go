@@ -0,0 +1,247 @@
+package slices
+
+import (
+	"reflect"
+	"strconv"
+	"testing"
+)
+
+func TestChunk(t *testing.T) {
+	type args[T any] struct {
+		org       []T
+		chunkSize int
+	}
+
+	type test[M any] struct {
+		name string
+		args args[M]
+		want [][]M
+	}
+
+	tests := []test[int]{
+		{
+			name: "want OK",
+			args: args[int]{
+				org:       []int{1, 2, 3, 4, 5},
+				chunkSize: 2,
+			},
+			want: [][]int{{1, 2}, {3, 4}, {5}},
+		},
+		{
+			name: "want OK2",
+			args: args[int]{
+				org:       []int{1, 2, 3, 4, 5},
+				chunkSize: 1,
+			},
+			want: [][]int{{1}, {2}, {3}, {4}, {5}},
+		},
+		{
+			name: "evenly divided",
+			args: args[int]{
+				org:       []int{1, 2, 3, 4},
+				chunkSize: 2,
+			},
+			want: [][]int{{1, 2}, {3, 4}},
+		},
+		{
+			name: "larger than org",
+			args: args[int]{
+				org:       []int{1, 2},
+				chunkSize: 5,
+			},
+			want: [][]int{{1, 2}},
+		},
+		{
+			name: "empty",
+			args: args[int]{
+				org:       []int{},
+				chunkSize: 2,
+			},
+			want: nil,
+		},
+		{
+			name: "zero size",
+			args: args[int]{
+				org:       []int{1, 2},
+				chunkSize: 0,
+			},
+			want: nil,
+		},
+		{
+			name: "negative size",
+			args: args[int]{
+				org:       []int{1, 2},
+				chunkSize: -1,
+			},
+			want: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Chunk(tt.args.org, tt.args.chunkSize); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Chunk() = %v, want %v", got, tt.want)
+			}
+			if got := ChunkCopy(tt.args.org, tt.args.chunkSize); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ChunkCopy() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	t.Run("append does not overwrite next chunk", func(t *testing.T) {
+		org := []int{1, 2, 3, 4}
+		chunked := Chunk(org, 2)
+		chunked[0] = append(chunked[0], 99)
+		if want := [][]int{{1, 2, 99}, {3, 4}}; !reflect.DeepEqual(chunked, want) {
+			t.Errorf("Chunk() = %v, want %v", chunked, want)
+		}
+		if want := []int{1, 2, 3, 4}; !reflect.DeepEqual(org, want) {
+			t.Errorf("org = %v, want %v", org, want)
+		}
+	})
+
+	t.Run("copy does not alias org", func(t *testing.T) {
+		org := []int{1, 2, 3, 4}
+		chunked := ChunkCopy(org, 2)
+		chunked[0][0] = 99
+		if org[0] != 1 {
+			t.Errorf("org = %v, want unchanged", org)
+		}
+	})
+}
+
+func TestWindows(t *testing.T) {
+	type args[T any] struct {
+		org  []T
+		size int
+		step int
+	}
+
+	type test[M any] struct {
+		name string
+		args args[M]
+		want [][]M
+	}
+
+	tests := []test[int]{
+		{name: "sliding", args: args[int]{[]int{1, 2, 3, 4}, 2, 1}, want: [][]int{{1, 2}, {2, 3}, {3, 4}}},
+		{name: "step 2", args: args[int]{[]int{1, 2, 3, 4, 5}, 3, 2}, want: [][]int{{1, 2, 3}, {3, 4, 5}}},
+		{name: "drops partial", args: args[int]{[]int{1, 2, 3, 4, 5, 6}, 3, 2}, want: [][]int{{1, 2, 3}, {3, 4, 5}}},
+		{name: "step larger than size", args: args[int]{[]int{1, 2, 3, 4, 5}, 1, 3}, want: [][]int{{1}, {4}}},
+		{name: "size equals len", args: args[int]{[]int{1, 2}, 2, 1}, want: [][]int{{1, 2}}},
+		{name: "size larger than len", args: args[int]{[]int{1, 2}, 3, 1}, want: nil},
+		{name: "zero size", args: args[int]{[]int{1, 2}, 0, 1}, want: nil},
+		{name: "zero step", args: args[int]{[]int{1, 2}, 1, 0}, want: nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Windows(tt.args.org, tt.args.size, tt.args.step); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Windows() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSplitN(t *testing.T) {
+	type args[T any] struct {
+		org []T
+		n   int
+	}
+
+	type test[M any] struct {
+		name string
+		args args[M]
+		want [][]M
+	}
+
+	tests := []test[int]{
+		{name: "even", args: args[int]{[]int{1, 2, 3, 4}, 2}, want: [][]int{{1, 2}, {3, 4}}},
+		{name: "remainder first", args: args[int]{[]int{1, 2, 3, 4, 5, 6, 7}, 3}, want: [][]int{{1, 2, 3}, {4, 5}, {6, 7}}},
+		{name: "one part", args: args[int]{[]int{1, 2, 3}, 1}, want: [][]int{{1, 2, 3}}},
+		{name: "more parts than elements", args: args[int]{[]int{1, 2}, 4}, want: [][]int{{1}, {2}, {}, {}}},
+		{name: "empty", args: args[int]{[]int{}, 2}, want: [][]int{{}, {}}},
+		{name: "zero parts", args: args[int]{[]int{1, 2}, 0}, want: nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := SplitN(tt.args.org, tt.args.n); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("SplitN() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSplitWhen(t *testing.T) {
+	type args[T any] struct {
+		org []T
+		fn  func(T, T) bool
+	}
+
+	type test[M any] struct {
+		name string
+		args args[M]
+		want [][]M
+	}
+
+	notConsecutive := func(prev, next int) bool { return next != prev+1 }
+	tests := []test[int]{
+		{name: "runs", args: args[int]{[]int{1, 2, 3, 7, 8, 10}, notConsecutive}, want: [][]int{{1, 2, 3}, {7, 8}, {10}}},
+		{name: "single run", args: args[int]{[]int{4, 5, 6}, notConsecutive}, want: [][]int{{4, 5, 6}}},
+		{name: "single element", args: args[int]{[]int{4}, notConsecutive}, want: [][]int{{4}}},
+		{name: "empty", args: args[int]{[]int{}, notConsecutive}, want: nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := SplitWhen(tt.args.org, tt.args.fn); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("SplitWhen() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func benchmarkChunkInput(n int) []int {
+	org := make([]int, n)
+	for i := range org {
+		org[i] = i / 10
+	}
+	return org
+}
+
+func BenchmarkChunk(b *testing.B) {
+	for _, n := range []int{100, 10000, 1000000} {
+		org := benchmarkChunkInput(n)
+		b.Run("Chunk/n="+strconv.Itoa(n), func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				Chunk(org, 16)
+			}
+		})
+		b.Run("ChunkCopy/n="+strconv.Itoa(n), func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				ChunkCopy(org, 16)
+			}
+		})
+		b.Run("Windows/n="+strconv.Itoa(n), func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				Windows(org, 16, 4)
+			}
+		})
+		b.Run("SplitN/n="+strconv.Itoa(n), func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				SplitN(org, 8)
+			}
+		})
+		b.Run("SplitWhen/n="+strconv.Itoa(n), func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				SplitWhen(org, func(prev, next int) bool { return prev != next })
+			}
+		})
+	}
+}
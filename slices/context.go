@@ -0,0 +1,158 @@
+package slices
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+// IndexError is returned by the Err variants when the callback fails or the
+// context is cancelled while processing the element at Index.
+type IndexError struct {
+	Index int
+	Err   error
+}
+
+func (e *IndexError) Error() string {
+	return fmt.Sprintf("index %d: %v", e.Index, e.Err)
+}
+
+func (e *IndexError) Unwrap() error {
+	return e.Err
+}
+
+type errConfig struct {
+	collect bool
+}
+
+func newErrConfig(opts []ErrOption) errConfig {
+	var cfg errConfig
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+	return cfg
+}
+
+// ErrOption configures the Err variants.
+type ErrOption func(*errConfig)
+
+// CollectErrors keeps going after a callback error and returns every error
+// joined with errors.Join. Context cancellation still stops the loop.
+func CollectErrors() ErrOption {
+	return func(c *errConfig) {
+		c.collect = true
+	}
+}
+
+// eachErr calls fn for each index until fn returns false, an error stops the
+// loop or ctx is done. Errors are wrapped in *IndexError. Without CollectErrors
+// the single *IndexError is returned as is, so callers can type assert it.
+func eachErr(ctx context.Context, n int, cfg errConfig, fn func(i int) (bool, error)) error {
+	var errs []error
+	for i := 0; i < n; i++ {
+		if err := ctx.Err(); err != nil {
+			errs = append(errs, &IndexError{Index: i, Err: err})
+			break
+		}
+		next, err := fn(i)
+		if err != nil {
+			errs = append(errs, &IndexError{Index: i, Err: err})
+			if !cfg.collect {
+				break
+			}
+		}
+		if !next {
+			break
+		}
+	}
+	switch {
+	case len(errs) == 0:
+		return nil
+	case !cfg.collect:
+		return errs[0]
+	}
+	return errors.Join(errs...)
+}
+
+// FilterErr is Filter with a context-aware callback that can fail.
+// It returns nil and the error if anything went wrong, unless CollectErrors is given,
+// in which case the elements matched so far are returned along with the joined errors.
+func FilterErr[T any](ctx context.Context, elms []T, fn func(context.Context, T) (bool, error), opts ...ErrOption) ([]T, error) {
+	cfg := newErrConfig(opts)
+	var ret []T
+	err := eachErr(ctx, len(elms), cfg, func(i int) (bool, error) {
+		match, err := fn(ctx, elms[i])
+		if err != nil {
+			return true, err
+		}
+		if match {
+			ret = append(ret, elms[i])
+		}
+		return true, nil
+	})
+	if err != nil && !cfg.collect {
+		return nil, err
+	}
+	return ret, err
+}
+
+// MapErr maps every element with a context-aware callback that can fail.
+// It returns nil and the error if anything went wrong, unless CollectErrors is given,
+// in which case the successfully mapped values are returned along with the joined errors.
+func MapErr[T any, R any](ctx context.Context, elms []T, fn func(context.Context, T) (R, error), opts ...ErrOption) ([]R, error) {
+	cfg := newErrConfig(opts)
+	ret := make([]R, 0, len(elms))
+	err := eachErr(ctx, len(elms), cfg, func(i int) (bool, error) {
+		v, err := fn(ctx, elms[i])
+		if err != nil {
+			return true, err
+		}
+		ret = append(ret, v)
+		return true, nil
+	})
+	if err != nil && !cfg.collect {
+		return nil, err
+	}
+	return ret, err
+}
+
+// EveryErr is Every with a context-aware callback that can fail.
+// It reports false whenever an error is returned.
+func EveryErr[T any](ctx context.Context, elms []T, fn func(context.Context, T) (bool, error), opts ...ErrOption) (bool, error) {
+	cfg := newErrConfig(opts)
+	every := true
+	err := eachErr(ctx, len(elms), cfg, func(i int) (bool, error) {
+		ok, err := fn(ctx, elms[i])
+		if err != nil {
+			return true, err
+		}
+		if !ok {
+			every = false
+			return false, nil
+		}
+		return true, nil
+	})
+	if err != nil {
+		return false, err
+	}
+	return every, nil
+}
+
+// SomeErr is Some with a context-aware callback that can fail.
+// A match found before any error is still reported along with the error.
+func SomeErr[T any](ctx context.Context, elms []T, fn func(context.Context, T) (bool, error), opts ...ErrOption) (bool, error) {
+	cfg := newErrConfig(opts)
+	some := false
+	err := eachErr(ctx, len(elms), cfg, func(i int) (bool, error) {
+		ok, err := fn(ctx, elms[i])
+		if err != nil {
+			return true, err
+		}
+		if ok {
+			some = true
+			return false, nil
+		}
+		return true, nil
+	})
+	return some, err
+}
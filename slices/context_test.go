@@ -0,0 +1,167 @@
+package slices
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"strconv"
+	"testing"
+)
+
+var errOdd = errors.New("odd")
+
+func TestFilterErr(t *testing.T) {
+	isEven := func(_ context.Context, v int) (bool, error) {
+		return v%2 == 0, nil
+	}
+	failOdd := func(_ context.Context, v int) (bool, error) {
+		if v%2 == 1 {
+			return false, errOdd
+		}
+		return true, nil
+	}
+
+	t.Run("filtered", func(t *testing.T) {
+		got, err := FilterErr(context.Background(), []int{1, 2, 3, 4}, isEven)
+		if err != nil || !reflect.DeepEqual(got, []int{2, 4}) {
+			t.Errorf("FilterErr() = %v, %v, want %v, nil", got, err, []int{2, 4})
+		}
+	})
+
+	t.Run("stops at first error", func(t *testing.T) {
+		got, err := FilterErr(context.Background(), []int{2, 3, 4, 5}, failOdd)
+		idxErr, ok := err.(*IndexError)
+		if got != nil || !ok || idxErr.Index != 1 || !errors.Is(err, errOdd) {
+			t.Errorf("FilterErr() = %v, %v, want nil, index 1: odd", got, err)
+		}
+	})
+
+	t.Run("collects errors", func(t *testing.T) {
+		got, err := FilterErr(context.Background(), []int{2, 3, 4, 5}, failOdd, CollectErrors())
+		if !reflect.DeepEqual(got, []int{2, 4}) {
+			t.Errorf("FilterErr() = %v, want %v", got, []int{2, 4})
+		}
+		if err == nil || err.Error() != "index 1: odd\nindex 3: odd" {
+			t.Errorf("FilterErr() error = %v", err)
+		}
+	})
+}
+
+func TestMapErr(t *testing.T) {
+	atoi := func(_ context.Context, s string) (int, error) {
+		return strconv.Atoi(s)
+	}
+
+	t.Run("mapped", func(t *testing.T) {
+		got, err := MapErr(context.Background(), []string{"1", "2", "3"}, atoi)
+		if err != nil || !reflect.DeepEqual(got, []int{1, 2, 3}) {
+			t.Errorf("MapErr() = %v, %v, want %v, nil", got, err, []int{1, 2, 3})
+		}
+	})
+
+	t.Run("empty", func(t *testing.T) {
+		got, err := MapErr(context.Background(), []string{}, atoi)
+		if err != nil || len(got) != 0 {
+			t.Errorf("MapErr() = %v, %v, want empty, nil", got, err)
+		}
+	})
+
+	t.Run("stops at first error", func(t *testing.T) {
+		calls := 0
+		got, err := MapErr(context.Background(), []string{"1", "a", "b"}, func(ctx context.Context, s string) (int, error) {
+			calls++
+			return atoi(ctx, s)
+		})
+		idxErr, ok := err.(*IndexError)
+		if got != nil || !ok || idxErr.Index != 1 || calls != 2 {
+			t.Errorf("MapErr() = %v, %v after %d calls", got, err, calls)
+		}
+	})
+
+	t.Run("collects errors", func(t *testing.T) {
+		got, err := MapErr(context.Background(), []string{"1", "a", "3", "b"}, atoi, CollectErrors())
+		if !reflect.DeepEqual(got, []int{1, 3}) {
+			t.Errorf("MapErr() = %v, want %v", got, []int{1, 3})
+		}
+		var numErr *strconv.NumError
+		if !errors.As(err, &numErr) {
+			t.Errorf("MapErr() error = %v, want *strconv.NumError", err)
+		}
+	})
+
+	t.Run("cancelled", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		got, err := MapErr(ctx, []string{"1", "2", "3"}, func(ctx context.Context, s string) (int, error) {
+			if s == "2" {
+				cancel()
+			}
+			return atoi(ctx, s)
+		}, CollectErrors())
+		var idxErr *IndexError
+		if !reflect.DeepEqual(got, []int{1, 2}) || !errors.As(err, &idxErr) || idxErr.Index != 2 || !errors.Is(err, context.Canceled) {
+			t.Errorf("MapErr() = %v, %v, want [1 2], index 2: context canceled", got, err)
+		}
+	})
+}
+
+func TestEveryErr(t *testing.T) {
+	positive := func(_ context.Context, v int) (bool, error) {
+		if v == 0 {
+			return false, errors.New("zero")
+		}
+		return v > 0, nil
+	}
+
+	tests := []struct {
+		name    string
+		src     []int
+		want    bool
+		wantErr bool
+	}{
+		{name: "empty", src: []int{}, want: true},
+		{name: "all", src: []int{1, 2, 3}, want: true},
+		{name: "not all", src: []int{1, -2, 3}, want: false},
+		{name: "error", src: []int{1, 0, 3}, want: false, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := EveryErr(context.Background(), tt.src, positive)
+			if got != tt.want || (err != nil) != tt.wantErr {
+				t.Errorf("EveryErr() = %v, %v, want %v, error %v", got, err, tt.want, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestSomeErr(t *testing.T) {
+	negative := func(_ context.Context, v int) (bool, error) {
+		if v == 0 {
+			return false, errors.New("zero")
+		}
+		return v < 0, nil
+	}
+
+	tests := []struct {
+		name    string
+		src     []int
+		opts    []ErrOption
+		want    bool
+		wantErr bool
+	}{
+		{name: "empty", src: []int{}, want: false},
+		{name: "found", src: []int{1, -2, 0}, want: true},
+		{name: "not found", src: []int{1, 2, 3}, want: false},
+		{name: "error", src: []int{1, 0, -3}, want: false, wantErr: true},
+		{name: "found after error", src: []int{1, 0, -3}, opts: []ErrOption{CollectErrors()}, want: true, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := SomeErr(context.Background(), tt.src, negative, tt.opts...)
+			if got != tt.want || (err != nil) != tt.wantErr {
+				t.Errorf("SomeErr() = %v, %v, want %v, error %v", got, err, tt.want, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,87 @@
+package slices
+
+// Flatten concatenates the inner slices, e.g. the result of Chunk, into one slice.
+func Flatten[T any](elms [][]T) []T {
+	total := 0
+	for _, inner := range elms {
+		total += len(inner)
+	}
+	ret := make([]T, 0, total)
+	for _, inner := range elms {
+		ret = append(ret, inner...)
+	}
+	return ret
+}
+
+// FlatMap maps each element to any number of results and concatenates them in order.
+func FlatMap[T any, R any](elms []T, fn func(T) []R) []R {
+	var ret []R
+	for _, v := range elms {
+		ret = append(ret, fn(v)...)
+	}
+	return ret
+}
+
+// Nested is a value or an arbitrarily deep list of values, flattened by FlattenDeep.
+// It is implemented by Leaf and Branch.
+type Nested[T any] interface {
+	appendTo(dst []T) []T
+}
+
+// Leaf is a single value in a Nested structure.
+type Leaf[T any] struct {
+	Value T
+}
+
+func (l Leaf[T]) appendTo(dst []T) []T {
+	return append(dst, l.Value)
+}
+
+// Branch is a list of Nested values.
+type Branch[T any] []Nested[T]
+
+func (b Branch[T]) appendTo(dst []T) []T {
+	for _, n := range b {
+		dst = n.appendTo(dst)
+	}
+	return dst
+}
+
+// FlattenDeep returns every Leaf value under elms in depth-first order.
+func FlattenDeep[T any](elms ...Nested[T]) []T {
+	return Branch[T](elms).appendTo(nil)
+}
+
+// Intersperse returns a copy of elms with sep between every pair of adjacent elements.
+func Intersperse[T any](elms []T, sep T) []T {
+	if len(elms) == 0 {
+		return []T{}
+	}
+	ret := make([]T, 0, 2*len(elms)-1)
+	for i, v := range elms {
+		if i > 0 {
+			ret = append(ret, sep)
+		}
+		ret = append(ret, v)
+	}
+	return ret
+}
+
+// Join concatenates the inner slices with sep between each of them.
+func Join[T any](elms [][]T, sep []T) []T {
+	if len(elms) == 0 {
+		return []T{}
+	}
+	total := len(sep) * (len(elms) - 1)
+	for _, inner := range elms {
+		total += len(inner)
+	}
+	ret := make([]T, 0, total)
+	for i, inner := range elms {
+		if i > 0 {
+			ret = append(ret, sep...)
+		}
+		ret = append(ret, inner...)
+	}
+	return ret
+}
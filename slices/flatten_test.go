@@ -0,0 +1,128 @@
+package slices
+
+import (
+	"reflect"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func TestFlatten(t *testing.T) {
+	tests := []struct {
+		name string
+		src  [][]int
+		want []int
+	}{
+		{name: "empty", src: nil, want: []int{}},
+		{name: "empty inner", src: [][]int{{}, nil, {1}}, want: []int{1}},
+		{name: "chunked", src: Chunk([]int{1, 2, 3, 4, 5}, 2), want: []int{1, 2, 3, 4, 5}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Flatten(tt.src); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Flatten() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFlatMap(t *testing.T) {
+	tests := []struct {
+		name string
+		src  []string
+		fn   func(string) []string
+		want []string
+	}{
+		{name: "empty", src: []string{}, fn: strings.Fields, want: nil},
+		{name: "split", src: []string{"a b", "", "c"}, fn: strings.Fields, want: []string{"a", "b", "c"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := FlatMap(tt.src, tt.fn); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("FlatMap() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	t.Run("composes with Map and Filter", func(t *testing.T) {
+		got := FlatMap(Filter([]int{1, 2, 3}, func(v int) bool { return v != 2 }), func(v int) []string {
+			return Map([]int{v, v * 10}, strconv.Itoa)
+		})
+		if want := []string{"1", "10", "3", "30"}; !reflect.DeepEqual(got, want) {
+			t.Errorf("FlatMap() = %v, want %v", got, want)
+		}
+	})
+}
+
+func TestFlattenDeep(t *testing.T) {
+	tests := []struct {
+		name string
+		src  []Nested[int]
+		want []int
+	}{
+		{name: "empty", src: nil, want: nil},
+		{name: "flat", src: []Nested[int]{Leaf[int]{1}, Leaf[int]{2}}, want: []int{1, 2}},
+		{
+			name: "deep",
+			src: []Nested[int]{
+				Leaf[int]{1},
+				Branch[int]{Leaf[int]{2}, Branch[int]{Branch[int]{Leaf[int]{3}}, Leaf[int]{4}}},
+				Branch[int]{},
+				Leaf[int]{5},
+			},
+			want: []int{1, 2, 3, 4, 5},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := FlattenDeep(tt.src...); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("FlattenDeep() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIntersperse(t *testing.T) {
+	tests := []struct {
+		name string
+		src  []string
+		want []string
+	}{
+		{name: "empty", src: nil, want: []string{}},
+		{name: "single", src: []string{"a"}, want: []string{"a"}},
+		{name: "many", src: []string{"a", "b", "c"}, want: []string{"a", ",", "b", ",", "c"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Intersperse(tt.src, ","); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Intersperse() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestJoin(t *testing.T) {
+	tests := []struct {
+		name string
+		src  [][]int
+		sep  []int
+		want []int
+	}{
+		{name: "empty", src: nil, sep: []int{0}, want: []int{}},
+		{name: "single", src: [][]int{{1, 2}}, sep: []int{0}, want: []int{1, 2}},
+		{name: "many", src: [][]int{{1, 2}, {}, {3}}, sep: []int{0, 0}, want: []int{1, 2, 0, 0, 0, 0, 3}},
+		{name: "no separator", src: [][]int{{1}, {2}}, sep: nil, want: []int{1, 2}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Join(tt.src, tt.sep); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Join() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,74 @@
+package slices
+
+import (
+	"errors"
+	"fmt"
+)
+
+// DuplicatePolicy decides what happens when two elements produce the same key.
+type DuplicatePolicy int
+
+const (
+	KeepFirst DuplicatePolicy = iota
+	KeepLast
+	ErrorOnDuplicate
+)
+
+var ErrDuplicateKey = errors.New("duplicate key")
+
+// GroupBy collects elements sharing a key, keeping their input order within each group.
+func GroupBy[T any, K comparable](elms []T, key func(T) K) map[K][]T {
+	ret := make(map[K][]T)
+	for _, v := range elms {
+		k := key(v)
+		ret[k] = append(ret[k], v)
+	}
+	return ret
+}
+
+// KeyBy maps each key to a single element, resolving duplicates with policy.
+// With ErrorOnDuplicate the returned error wraps ErrDuplicateKey.
+func KeyBy[T any, K comparable](elms []T, key func(T) K, policy DuplicatePolicy) (map[K]T, error) {
+	ret := make(map[K]T, len(elms))
+	for i, v := range elms {
+		k := key(v)
+		if _, ok := ret[k]; ok {
+			switch policy {
+			case KeepFirst:
+				continue
+			case ErrorOnDuplicate:
+				return nil, fmt.Errorf("%w %v at index %d", ErrDuplicateKey, k, i)
+			}
+		}
+		ret[k] = v
+	}
+	return ret, nil
+}
+
+func CountBy[T any, K comparable](elms []T, key func(T) K) map[K]int {
+	ret := make(map[K]int)
+	for _, v := range elms {
+		ret[key(v)]++
+	}
+	return ret
+}
+
+func Frequencies[T comparable](elms []T) map[T]int {
+	ret := make(map[T]int)
+	for _, v := range elms {
+		ret[v]++
+	}
+	return ret
+}
+
+// Partition splits elements into those fn matches and the rest in one pass.
+func Partition[T any](elms []T, fn func(T) bool) (matched []T, unmatched []T) {
+	for _, v := range elms {
+		if fn(v) {
+			matched = append(matched, v)
+		} else {
+			unmatched = append(unmatched, v)
+		}
+	}
+	return matched, unmatched
+}
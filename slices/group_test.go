@@ -0,0 +1,143 @@
+package slices
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+type groupOriginal struct {
+	id   int
+	name string
+}
+
+var groupSrc = []groupOriginal{{1, "john"}, {2, "jack"}, {1, "jade"}, {3, "bob"}}
+
+func TestGroupBy(t *testing.T) {
+	tests := []struct {
+		name string
+		src  []groupOriginal
+		want map[int][]groupOriginal
+	}{
+		{name: "empty", src: nil, want: map[int][]groupOriginal{}},
+		{
+			name: "grouped",
+			src:  groupSrc,
+			want: map[int][]groupOriginal{
+				1: {{1, "john"}, {1, "jade"}},
+				2: {{2, "jack"}},
+				3: {{3, "bob"}},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := GroupBy(tt.src, func(o groupOriginal) int { return o.id }); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("GroupBy() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestKeyBy(t *testing.T) {
+	tests := []struct {
+		name    string
+		policy  DuplicatePolicy
+		want    map[int]groupOriginal
+		wantErr error
+	}{
+		{
+			name:   "keep first",
+			policy: KeepFirst,
+			want:   map[int]groupOriginal{1: {1, "john"}, 2: {2, "jack"}, 3: {3, "bob"}},
+		},
+		{
+			name:   "keep last",
+			policy: KeepLast,
+			want:   map[int]groupOriginal{1: {1, "jade"}, 2: {2, "jack"}, 3: {3, "bob"}},
+		},
+		{
+			name:    "error",
+			policy:  ErrorOnDuplicate,
+			wantErr: ErrDuplicateKey,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := KeyBy(groupSrc, func(o groupOriginal) int { return o.id }, tt.policy)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("KeyBy() error = %v, want %v", err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("KeyBy() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	t.Run("error message", func(t *testing.T) {
+		_, err := KeyBy(groupSrc, func(o groupOriginal) int { return o.id }, ErrorOnDuplicate)
+		if want := "duplicate key 1 at index 2"; err == nil || err.Error() != want {
+			t.Errorf("KeyBy() error = %v, want %v", err, want)
+		}
+	})
+
+	t.Run("unique keys", func(t *testing.T) {
+		got, err := KeyBy(groupSrc, func(o groupOriginal) string { return o.name }, ErrorOnDuplicate)
+		if err != nil || len(got) != len(groupSrc) {
+			t.Errorf("KeyBy() = %v, %v", got, err)
+		}
+	})
+}
+
+func TestCountBy(t *testing.T) {
+	got := CountBy([]string{"apple", "avocado", "banana", "cherry", "blueberry"}, func(s string) string {
+		return s[:1]
+	})
+	if want := map[string]int{"a": 2, "b": 2, "c": 1}; !reflect.DeepEqual(got, want) {
+		t.Errorf("CountBy() = %v, want %v", got, want)
+	}
+}
+
+func TestFrequencies(t *testing.T) {
+	tests := []struct {
+		name string
+		src  []string
+		want map[string]int
+	}{
+		{name: "empty", src: []string{}, want: map[string]int{}},
+		{name: "counted", src: strings.Split("a b a c a b", " "), want: map[string]int{"a": 3, "b": 2, "c": 1}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Frequencies(tt.src); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Frequencies() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPartition(t *testing.T) {
+	tests := []struct {
+		name          string
+		src           []int
+		wantMatched   []int
+		wantUnmatched []int
+	}{
+		{name: "empty", src: []int{}},
+		{name: "split", src: []int{1, 2, 3, 4, 5}, wantMatched: []int{2, 4}, wantUnmatched: []int{1, 3, 5}},
+		{name: "all matched", src: []int{2, 4}, wantMatched: []int{2, 4}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			matched, unmatched := Partition(tt.src, func(v int) bool { return v%2 == 0 })
+			if !reflect.DeepEqual(matched, tt.wantMatched) || !reflect.DeepEqual(unmatched, tt.wantUnmatched) {
+				t.Errorf("Partition() = %v, %v, want %v, %v", matched, unmatched, tt.wantMatched, tt.wantUnmatched)
+			}
+		})
+	}
+}
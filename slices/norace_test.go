@@ -0,0 +1,5 @@
+//go:build !race
+
+package slices
+
+const raceEnabled = false
@@ -0,0 +1,133 @@
+package slices
+
+import (
+	"runtime"
+	"sync"
+	"sync/atomic"
+)
+
+// parallelDo calls fn for every index of an n-length input from at most
+// workers goroutines. Workers stop picking up new indexes once fn returns false.
+// workers <= 0 means runtime.GOMAXPROCS(0).
+func parallelDo(n, workers int, fn func(i int) bool) {
+	if workers <= 0 {
+		workers = runtime.GOMAXPROCS(0)
+	}
+	if workers > n {
+		workers = n
+	}
+
+	var next int64 = -1
+	var stop int32
+	var wg sync.WaitGroup
+	wg.Add(workers)
+	for w := 0; w < workers; w++ {
+		go func() {
+			defer wg.Done()
+			for atomic.LoadInt32(&stop) == 0 {
+				i := int(atomic.AddInt64(&next, 1))
+				if i >= n {
+					return
+				}
+				if !fn(i) {
+					atomic.StoreInt32(&stop, 1)
+					return
+				}
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+// ParallelFilter is Filter with fn called from at most workers goroutines.
+// The result keeps the input order and is sized to the number of matches.
+func ParallelFilter[T any](elms []T, workers int, fn func(T) bool) []T {
+	matched := make([]bool, len(elms))
+	var n int64
+	parallelDo(len(elms), workers, func(i int) bool {
+		if matched[i] = fn(elms[i]); matched[i] {
+			atomic.AddInt64(&n, 1)
+		}
+		return true
+	})
+	if n == 0 {
+		return nil
+	}
+
+	ret := make([]T, 0, n)
+	for i, v := range elms {
+		if matched[i] {
+			ret = append(ret, v)
+		}
+	}
+	return ret
+}
+
+// ParallelMap is Map with fn called from at most workers goroutines.
+// The result keeps the input order.
+func ParallelMap[T any, R any](elms []T, workers int, fn func(T) R) []R {
+	ret := make([]R, len(elms))
+	parallelDo(len(elms), workers, func(i int) bool {
+		ret[i] = fn(elms[i])
+		return true
+	})
+	return ret
+}
+
+// ParallelFilterMap is FilterMap with fn called from at most workers goroutines.
+// The result keeps the input order and is sized to the number of kept values.
+func ParallelFilterMap[T any, R any](elms []T, workers int, fn func(T) (R, bool)) []R {
+	type result struct {
+		v  R
+		ok bool
+	}
+	results := make([]result, len(elms))
+	var n int64
+	parallelDo(len(elms), workers, func(i int) bool {
+		v, ok := fn(elms[i])
+		results[i] = result{v, ok}
+		if ok {
+			atomic.AddInt64(&n, 1)
+		}
+		return true
+	})
+	if n == 0 {
+		return nil
+	}
+
+	ret := make([]R, 0, n)
+	for _, r := range results {
+		if r.ok {
+			ret = append(ret, r.v)
+		}
+	}
+	return ret
+}
+
+// ParallelEvery is Every with fn called from at most workers goroutines.
+// Remaining elements are skipped once fn returns false.
+func ParallelEvery[T any](elms []T, workers int, fn func(T) bool) bool {
+	var failed int32
+	parallelDo(len(elms), workers, func(i int) bool {
+		if !fn(elms[i]) {
+			atomic.StoreInt32(&failed, 1)
+			return false
+		}
+		return true
+	})
+	return atomic.LoadInt32(&failed) == 0
+}
+
+// ParallelSome is Some with fn called from at most workers goroutines.
+// Remaining elements are skipped once fn returns true.
+func ParallelSome[T any](elms []T, workers int, fn func(T) bool) bool {
+	var found int32
+	parallelDo(len(elms), workers, func(i int) bool {
+		if fn(elms[i]) {
+			atomic.StoreInt32(&found, 1)
+			return false
+		}
+		return true
+	})
+	return atomic.LoadInt32(&found) == 1
+}
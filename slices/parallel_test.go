@@ -0,0 +1,168 @@
+package slices
+
+import (
+	"reflect"
+	"strconv"
+	"sync/atomic"
+	"testing"
+)
+
+func parallelInput(n int) []int {
+	src := make([]int, n)
+	for i := range src {
+		src[i] = i
+	}
+	return src
+}
+
+var parallelWorkers = []int{-1, 0, 1, 2, 3, 7, 64, 2000}
+
+func TestParallelFilter(t *testing.T) {
+	type test[T any] struct {
+		name string
+		src  []T
+		fn   func(T) bool
+	}
+
+	tests := []test[int]{
+		{name: "empty", src: []int{}, fn: func(v int) bool { return true }},
+		{name: "none match", src: parallelInput(100), fn: func(v int) bool { return v < 0 }},
+		{name: "all match", src: parallelInput(100), fn: func(v int) bool { return v >= 0 }},
+		{name: "even", src: parallelInput(1000), fn: func(v int) bool { return v%2 == 0 }},
+	}
+
+	for _, tt := range tests {
+		want := Filter(tt.src, tt.fn)
+		for _, w := range parallelWorkers {
+			t.Run(tt.name+"/workers="+strconv.Itoa(w), func(t *testing.T) {
+				if got := ParallelFilter(tt.src, w, tt.fn); !reflect.DeepEqual(got, want) {
+					t.Errorf("ParallelFilter() = %v, want %v", got, want)
+				}
+			})
+		}
+	}
+}
+
+func TestParallelMap(t *testing.T) {
+	type test[T any, R any] struct {
+		name string
+		src  []T
+		fn   func(T) R
+	}
+
+	tests := []test[int, string]{
+		{name: "empty", src: []int{}, fn: strconv.Itoa},
+		{name: "mapped", src: parallelInput(1000), fn: strconv.Itoa},
+	}
+
+	for _, tt := range tests {
+		want := Map(tt.src, tt.fn)
+		for _, w := range parallelWorkers {
+			t.Run(tt.name+"/workers="+strconv.Itoa(w), func(t *testing.T) {
+				if got := ParallelMap(tt.src, w, tt.fn); !reflect.DeepEqual(got, want) {
+					t.Errorf("ParallelMap() = %v, want %v", got, want)
+				}
+			})
+		}
+	}
+}
+
+func TestParallelFilterMap(t *testing.T) {
+	type test[T any, R any] struct {
+		name string
+		src  []T
+		fn   func(T) (R, bool)
+	}
+
+	tests := []test[int, string]{
+		{name: "empty", src: []int{}, fn: func(v int) (string, bool) { return strconv.Itoa(v), true }},
+		{name: "none kept", src: parallelInput(100), fn: func(v int) (string, bool) { return "", false }},
+		{name: "all kept", src: parallelInput(1000), fn: func(v int) (string, bool) { return strconv.Itoa(v), true }},
+		{name: "odd kept", src: parallelInput(1000), fn: func(v int) (string, bool) { return strconv.Itoa(v), v%2 == 1 }},
+	}
+
+	for _, tt := range tests {
+		want := FilterMap(tt.src, tt.fn)
+		for _, w := range parallelWorkers {
+			t.Run(tt.name+"/workers="+strconv.Itoa(w), func(t *testing.T) {
+				if got := ParallelFilterMap(tt.src, w, tt.fn); !reflect.DeepEqual(got, want) {
+					t.Errorf("ParallelFilterMap() = %v, want %v", got, want)
+				}
+			})
+		}
+	}
+}
+
+func TestParallelEvery(t *testing.T) {
+	type test[T any] struct {
+		name string
+		src  []T
+		fn   func(T) bool
+	}
+
+	tests := []test[int]{
+		{name: "empty", src: []int{}, fn: func(v int) bool { return false }},
+		{name: "all true", src: parallelInput(1000), fn: func(v int) bool { return v >= 0 }},
+		{name: "last false", src: parallelInput(1000), fn: func(v int) bool { return v != 999 }},
+		{name: "first false", src: parallelInput(1000), fn: func(v int) bool { return v != 0 }},
+	}
+
+	for _, tt := range tests {
+		want := Every(tt.src, tt.fn)
+		for _, w := range parallelWorkers {
+			t.Run(tt.name+"/workers="+strconv.Itoa(w), func(t *testing.T) {
+				if got := ParallelEvery(tt.src, w, tt.fn); got != want {
+					t.Errorf("ParallelEvery() = %v, want %v", got, want)
+				}
+			})
+		}
+	}
+
+	t.Run("stops early", func(t *testing.T) {
+		var calls int32
+		ParallelEvery(parallelInput(1000), 1, func(v int) bool {
+			atomic.AddInt32(&calls, 1)
+			return v < 10
+		})
+		if calls != 11 {
+			t.Errorf("ParallelEvery() called fn %d times, want 11", calls)
+		}
+	})
+}
+
+func TestParallelSome(t *testing.T) {
+	type test[T any] struct {
+		name string
+		src  []T
+		fn   func(T) bool
+	}
+
+	tests := []test[int]{
+		{name: "empty", src: []int{}, fn: func(v int) bool { return true }},
+		{name: "all false", src: parallelInput(1000), fn: func(v int) bool { return v < 0 }},
+		{name: "last true", src: parallelInput(1000), fn: func(v int) bool { return v == 999 }},
+		{name: "first true", src: parallelInput(1000), fn: func(v int) bool { return v == 0 }},
+	}
+
+	for _, tt := range tests {
+		want := Some(tt.src, tt.fn)
+		for _, w := range parallelWorkers {
+			t.Run(tt.name+"/workers="+strconv.Itoa(w), func(t *testing.T) {
+				if got := ParallelSome(tt.src, w, tt.fn); got != want {
+					t.Errorf("ParallelSome() = %v, want %v", got, want)
+				}
+			})
+		}
+	}
+
+	t.Run("stops early", func(t *testing.T) {
+		var calls int32
+		ParallelSome(parallelInput(1000), 1, func(v int) bool {
+			atomic.AddInt32(&calls, 1)
+			return v == 10
+		})
+		if calls != 11 {
+			t.Errorf("ParallelSome() called fn %d times, want 11", calls)
+		}
+	})
+}
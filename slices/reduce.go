@@ -0,0 +1,115 @@
+package slices
+
+import "cmp"
+
+// Number is satisfied by every integer and floating-point type.
+type Number interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 |
+		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr |
+		~float32 | ~float64
+}
+
+// Reduce combines elements from left to right, starting with the first element.
+// It returns false for an empty slice.
+func Reduce[T any](elms []T, fn func(T, T) T) (T, bool) {
+	if len(elms) == 0 {
+		var zero T
+		return zero, false
+	}
+	acc := elms[0]
+	for _, v := range elms[1:] {
+		acc = fn(acc, v)
+	}
+	return acc, true
+}
+
+func FoldLeft[T any, R any](elms []T, init R, fn func(R, T) R) R {
+	acc := init
+	for _, v := range elms {
+		acc = fn(acc, v)
+	}
+	return acc
+}
+
+func FoldRight[T any, R any](elms []T, init R, fn func(T, R) R) R {
+	acc := init
+	for i := len(elms) - 1; i >= 0; i-- {
+		acc = fn(elms[i], acc)
+	}
+	return acc
+}
+
+// Scan is FoldLeft returning every intermediate accumulation.
+// The result has the same length as elms and does not include init.
+func Scan[T any, R any](elms []T, init R, fn func(R, T) R) []R {
+	ret := make([]R, len(elms))
+	acc := init
+	for i, v := range elms {
+		acc = fn(acc, v)
+		ret[i] = acc
+	}
+	return ret
+}
+
+// Sum returns 0 for an empty slice.
+func Sum[T Number](elms []T) T {
+	var sum T
+	for _, v := range elms {
+		sum += v
+	}
+	return sum
+}
+
+// Product returns 1 for an empty slice.
+func Product[T Number](elms []T) T {
+	var product T = 1
+	for _, v := range elms {
+		product *= v
+	}
+	return product
+}
+
+// Min returns false for an empty slice.
+func Min[T cmp.Ordered](elms []T) (T, bool) {
+	return Reduce(elms, func(a, b T) T {
+		if cmp.Less(b, a) {
+			return b
+		}
+		return a
+	})
+}
+
+// Max returns false for an empty slice.
+func Max[T cmp.Ordered](elms []T) (T, bool) {
+	return Reduce(elms, func(a, b T) T {
+		if cmp.Less(a, b) {
+			return b
+		}
+		return a
+	})
+}
+
+// MinBy returns the first element with the smallest key, or false for an empty slice.
+func MinBy[T any, K cmp.Ordered](elms []T, key func(T) K) (T, bool) {
+	return extremeBy(elms, key, func(a, b K) bool { return cmp.Less(b, a) })
+}
+
+// MaxBy returns the first element with the largest key, or false for an empty slice.
+func MaxBy[T any, K cmp.Ordered](elms []T, key func(T) K) (T, bool) {
+	return extremeBy(elms, key, func(a, b K) bool { return cmp.Less(a, b) })
+}
+
+// extremeBy keeps the current element unless replace reports that the next key should win.
+func extremeBy[T any, K cmp.Ordered](elms []T, key func(T) K, replace func(cur, next K) bool) (T, bool) {
+	if len(elms) == 0 {
+		var zero T
+		return zero, false
+	}
+	ret, retKey := elms[0], key(elms[0])
+	for _, v := range elms[1:] {
+		if k := key(v); replace(retKey, k) {
+			ret, retKey = v, k
+		}
+	}
+	return ret, true
+}
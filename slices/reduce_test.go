@@ -0,0 +1,187 @@
+package slices
+
+import (
+	"reflect"
+	"strconv"
+	"testing"
+)
+
+func TestReduce(t *testing.T) {
+	type test[T any] struct {
+		name   string
+		src    []T
+		fn     func(T, T) T
+		want   T
+		wantOk bool
+	}
+
+	concat := func(a, b string) string { return a + b }
+	tests := []test[string]{
+		{name: "empty", src: []string{}, fn: concat, want: "", wantOk: false},
+		{name: "nil", src: nil, fn: concat, want: "", wantOk: false},
+		{name: "single", src: []string{"a"}, fn: concat, want: "a", wantOk: true},
+		{name: "left to right", src: []string{"a", "b", "c"}, fn: concat, want: "abc", wantOk: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got, ok := Reduce(tt.src, tt.fn); got != tt.want || ok != tt.wantOk {
+				t.Errorf("Reduce() = %v, %v, want %v, %v", got, ok, tt.want, tt.wantOk)
+			}
+		})
+	}
+}
+
+func TestFoldLeft(t *testing.T) {
+	fn := func(acc string, v int) string { return "(" + acc + "+" + strconv.Itoa(v) + ")" }
+
+	tests := []struct {
+		name string
+		src  []int
+		want string
+	}{
+		{name: "empty", src: []int{}, want: "0"},
+		{name: "left", src: []int{1, 2, 3}, want: "(((0+1)+2)+3)"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := FoldLeft(tt.src, "0", fn); got != tt.want {
+				t.Errorf("FoldLeft() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFoldRight(t *testing.T) {
+	fn := func(v int, acc string) string { return "(" + strconv.Itoa(v) + "+" + acc + ")" }
+
+	tests := []struct {
+		name string
+		src  []int
+		want string
+	}{
+		{name: "empty", src: []int{}, want: "0"},
+		{name: "right", src: []int{1, 2, 3}, want: "(1+(2+(3+0)))"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := FoldRight(tt.src, "0", fn); got != tt.want {
+				t.Errorf("FoldRight() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestScan(t *testing.T) {
+	sum := func(acc, v int) int { return acc + v }
+
+	tests := []struct {
+		name string
+		src  []int
+		init int
+		want []int
+	}{
+		{name: "empty", src: []int{}, init: 0, want: []int{}},
+		{name: "running sum", src: []int{1, 2, 3, 4}, init: 0, want: []int{1, 3, 6, 10}},
+		{name: "with init", src: []int{1, 2}, init: 10, want: []int{11, 13}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Scan(tt.src, tt.init, sum); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Scan() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSumProduct(t *testing.T) {
+	tests := []struct {
+		name        string
+		src         []float64
+		wantSum     float64
+		wantProduct float64
+	}{
+		{name: "empty", src: []float64{}, wantSum: 0, wantProduct: 1},
+		{name: "values", src: []float64{1.5, 2, 4}, wantSum: 7.5, wantProduct: 12},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Sum(tt.src); got != tt.wantSum {
+				t.Errorf("Sum() = %v, want %v", got, tt.wantSum)
+			}
+			if got := Product(tt.src); got != tt.wantProduct {
+				t.Errorf("Product() = %v, want %v", got, tt.wantProduct)
+			}
+		})
+	}
+
+	type celsius int
+	if got := Sum([]celsius{1, 2, 3}); got != 6 {
+		t.Errorf("Sum() = %v, want 6", got)
+	}
+}
+
+func TestMinMax(t *testing.T) {
+	tests := []struct {
+		name    string
+		src     []string
+		wantMin string
+		wantMax string
+		wantOk  bool
+	}{
+		{name: "empty", src: []string{}, wantOk: false},
+		{name: "single", src: []string{"b"}, wantMin: "b", wantMax: "b", wantOk: true},
+		{name: "values", src: []string{"b", "c", "a", "c"}, wantMin: "a", wantMax: "c", wantOk: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got, ok := Min(tt.src); got != tt.wantMin || ok != tt.wantOk {
+				t.Errorf("Min() = %v, %v, want %v, %v", got, ok, tt.wantMin, tt.wantOk)
+			}
+			if got, ok := Max(tt.src); got != tt.wantMax || ok != tt.wantOk {
+				t.Errorf("Max() = %v, %v, want %v, %v", got, ok, tt.wantMax, tt.wantOk)
+			}
+		})
+	}
+}
+
+func TestMinByMaxBy(t *testing.T) {
+	type original struct {
+		id   int
+		name string
+	}
+	byID := func(o original) int { return o.id }
+
+	tests := []struct {
+		name    string
+		src     []original
+		wantMin original
+		wantMax original
+		wantOk  bool
+	}{
+		{name: "empty", src: []original{}, wantOk: false},
+		{
+			name:    "first on ties",
+			src:     []original{{2, "john"}, {1, "jack"}, {3, "jade"}, {1, "joe"}, {3, "jim"}},
+			wantMin: original{1, "jack"},
+			wantMax: original{3, "jade"},
+			wantOk:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got, ok := MinBy(tt.src, byID); got != tt.wantMin || ok != tt.wantOk {
+				t.Errorf("MinBy() = %v, %v, want %v, %v", got, ok, tt.wantMin, tt.wantOk)
+			}
+			if got, ok := MaxBy(tt.src, byID); got != tt.wantMax || ok != tt.wantOk {
+				t.Errorf("MaxBy() = %v, %v, want %v, %v", got, ok, tt.wantMax, tt.wantOk)
+			}
+		})
+	}
+}
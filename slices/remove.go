@@ -0,0 +1,66 @@
+package slices
+
+// The InPlace, Func and Delete functions below compact elms in one linear pass,
+// reusing its backing array. Elements past the new length are zeroed so they can be
+// garbage collected, and callers must use the returned slice instead of elms.
+// Without and WithoutFunc never modify elms.
+
+// RemoveAllInPlace removes every element equal to tgt, modifying elms. O(n).
+func RemoveAllInPlace[T comparable](elms []T, tgt T) []T {
+	return RemoveFunc(elms, func(v T) bool {
+		return v == tgt
+	})
+}
+
+// RemoveFunc removes every element fn matches, modifying elms. O(n).
+func RemoveFunc[T any](elms []T, fn func(T) bool) []T {
+	kept := 0
+	for _, v := range elms {
+		if !fn(v) {
+			elms[kept] = v
+			kept++
+		}
+	}
+	clear(elms[kept:])
+	return elms[:kept]
+}
+
+// RetainInPlace keeps only the elements fn matches, modifying elms. O(n).
+func RetainInPlace[T any](elms []T, fn func(T) bool) []T {
+	return RemoveFunc(elms, func(v T) bool {
+		return !fn(v)
+	})
+}
+
+// DeleteAt removes the element at index i, modifying elms.
+// It panics if i is out of range.
+func DeleteAt[T any](elms []T, i int) []T {
+	return DeleteRange(elms, i, i+1)
+}
+
+// DeleteRange removes elms[i:j], modifying elms.
+// It panics if elms[i:j] is not a valid slice of elms.
+func DeleteRange[T any](elms []T, i, j int) []T {
+	_ = elms[i:j:len(elms)]
+	n := copy(elms[i:], elms[j:])
+	clear(elms[i+n:])
+	return elms[:i+n]
+}
+
+// Without returns a copy of elms without any of tgts. elms is not modified. O(n*len(tgts)).
+func Without[T comparable](elms []T, tgts ...T) []T {
+	return WithoutFunc(elms, func(v T) bool {
+		return Includes(tgts, v)
+	})
+}
+
+// WithoutFunc returns a copy of elms without the elements fn matches. elms is not modified. O(n).
+func WithoutFunc[T any](elms []T, fn func(T) bool) []T {
+	ret := make([]T, 0, len(elms))
+	for _, v := range elms {
+		if !fn(v) {
+			ret = append(ret, v)
+		}
+	}
+	return ret
+}
@@ -0,0 +1,168 @@
+package slices
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestInPlaceRemoval(t *testing.T) {
+	isEven := func(v int) bool { return v%2 == 0 }
+
+	tests := []struct {
+		name string
+		fn   func([]int) []int
+		src  []int
+		want []int
+		// wantSrc is the whole backing array afterwards: kept elements first, zeroed tail.
+		wantSrc []int
+	}{
+		{
+			name:    "RemoveAllInPlace",
+			fn:      func(s []int) []int { return RemoveAllInPlace(s, 2) },
+			src:     []int{2, 1, 2, 2, 3, 2},
+			want:    []int{1, 3},
+			wantSrc: []int{1, 3, 0, 0, 0, 0},
+		},
+		{
+			name:    "RemoveAllInPlace not found",
+			fn:      func(s []int) []int { return RemoveAllInPlace(s, 9) },
+			src:     []int{1, 2, 3},
+			want:    []int{1, 2, 3},
+			wantSrc: []int{1, 2, 3},
+		},
+		{
+			name:    "RemoveAllInPlace all",
+			fn:      func(s []int) []int { return RemoveAllInPlace(s, 2) },
+			src:     []int{2, 2},
+			want:    []int{},
+			wantSrc: []int{0, 0},
+		},
+		{
+			name:    "RemoveFunc",
+			fn:      func(s []int) []int { return RemoveFunc(s, isEven) },
+			src:     []int{1, 2, 3, 4, 5},
+			want:    []int{1, 3, 5},
+			wantSrc: []int{1, 3, 5, 0, 0},
+		},
+		{
+			name:    "RetainInPlace",
+			fn:      func(s []int) []int { return RetainInPlace(s, isEven) },
+			src:     []int{1, 2, 3, 4, 5},
+			want:    []int{2, 4},
+			wantSrc: []int{2, 4, 0, 0, 0},
+		},
+		{
+			name:    "DeleteAt",
+			fn:      func(s []int) []int { return DeleteAt(s, 1) },
+			src:     []int{1, 2, 3, 4},
+			want:    []int{1, 3, 4},
+			wantSrc: []int{1, 3, 4, 0},
+		},
+		{
+			name:    "DeleteAt last",
+			fn:      func(s []int) []int { return DeleteAt(s, 3) },
+			src:     []int{1, 2, 3, 4},
+			want:    []int{1, 2, 3},
+			wantSrc: []int{1, 2, 3, 0},
+		},
+		{
+			name:    "DeleteRange",
+			fn:      func(s []int) []int { return DeleteRange(s, 1, 3) },
+			src:     []int{1, 2, 3, 4, 5},
+			want:    []int{1, 4, 5},
+			wantSrc: []int{1, 4, 5, 0, 0},
+		},
+		{
+			name:    "DeleteRange empty range",
+			fn:      func(s []int) []int { return DeleteRange(s, 2, 2) },
+			src:     []int{1, 2, 3},
+			want:    []int{1, 2, 3},
+			wantSrc: []int{1, 2, 3},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.fn(tt.src)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+			if !reflect.DeepEqual(tt.src, tt.wantSrc) {
+				t.Errorf("input = %v, want it mutated to %v", tt.src, tt.wantSrc)
+			}
+		})
+	}
+}
+
+func TestDeleteRange_Panics(t *testing.T) {
+	tests := []struct {
+		name string
+		fn   func()
+	}{
+		{name: "DeleteAt negative", fn: func() { DeleteAt([]int{1}, -1) }},
+		{name: "DeleteAt out of range", fn: func() { DeleteAt([]int{1}, 1) }},
+		{name: "DeleteRange reversed", fn: func() { DeleteRange([]int{1, 2, 3}, 2, 1) }},
+		{name: "DeleteRange out of range", fn: func() { DeleteRange([]int{1, 2, 3}, 1, 4) }},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("did not panic")
+				}
+			}()
+			tt.fn()
+		})
+	}
+}
+
+func TestCopyingRemoval(t *testing.T) {
+	tests := []struct {
+		name string
+		fn   func([]int) []int
+		src  []int
+		want []int
+	}{
+		{
+			name: "Without",
+			fn:   func(s []int) []int { return Without(s, 2, 4) },
+			src:  []int{1, 2, 3, 4, 2, 5},
+			want: []int{1, 3, 5},
+		},
+		{
+			name: "Without nothing",
+			fn:   func(s []int) []int { return Without(s) },
+			src:  []int{1, 2},
+			want: []int{1, 2},
+		},
+		{
+			name: "WithoutFunc",
+			fn:   func(s []int) []int { return WithoutFunc(s, func(v int) bool { return v > 2 }) },
+			src:  []int{1, 2, 3, 4, 2, 5},
+			want: []int{1, 2, 2},
+		},
+		{
+			name: "RemoveAll",
+			fn:   func(s []int) []int { return RemoveAll(s, 2) },
+			src:  []int{2, 1, 2, 2, 3, 2},
+			want: []int{1, 3},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			orig := append([]int(nil), tt.src...)
+			got := tt.fn(tt.src)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+			if !reflect.DeepEqual(tt.src, orig) {
+				t.Errorf("input = %v, want it untouched as %v", tt.src, orig)
+			}
+			if len(got) > 0 && &got[0] == &tt.src[0] {
+				t.Errorf("result shares the backing array with the input")
+			}
+		})
+	}
+}
@@ -1,44 +1,98 @@
 package slices
 
-func Chunk[T any](org []T, chunkSize int) [][]T {
-	chunkedLength := func(len, size int) int {
-		if size == 1 {
-			return len / size
-		}
-		return len/size + 1
-	}(len(org), chunkSize)
+import stdslices "slices"
 
-	chunked := make([][]T, chunkedLength)
-	for i := 0; i < len(chunked); i++ {
-		tail := (i + 1) * chunkSize
-		if tail > len(org) {
-			chunked[i] = org[i*chunkSize:]
-		} else {
-			chunked[i] = org[i*chunkSize : tail]
-		}
+// Filter presizes the result for len(elms) matches, see trimPresized.
+// Use AppendFilter with a reused buffer on hot paths.
+func Filter[T any](elms []T, fn func(T) bool) []T {
+	return trimPresized(AppendFilter(make([]T, 0, len(elms)), elms, fn))
+}
+
+// trimPresized takes a result presized for every input element. It returns nil when
+// nothing was kept, and an exact copy when less than half the capacity is used,
+// so a selective filter pays one copy instead of holding on to the whole array.
+func trimPresized[T any](s []T) []T {
+	switch {
+	case len(s) == 0:
+		return nil
+	case len(s) < cap(s)/2:
+		return append(make([]T, 0, len(s)), s...)
 	}
-	return chunked
+	return s
 }
 
-func Filter[T any](elms []T, fn func(T) bool) []T {
-	var ret []T
+// AppendFilter appends the elements fn matches to dst and returns the extended slice.
+// It does not allocate when dst has enough spare capacity.
+func AppendFilter[T any](dst []T, elms []T, fn func(T) bool) []T {
 	for _, v := range elms {
 		if match := fn(v); match {
+			dst = append(dst, v)
+		}
+	}
+	return dst
+}
+
+// FilterIndexed is Filter with the index passed to fn.
+func FilterIndexed[T any](elms []T, fn func(int, T) bool) []T {
+	ret := make([]T, 0, len(elms))
+	for i, v := range elms {
+		if fn(i, v) {
 			ret = append(ret, v)
 		}
 	}
+	return trimPresized(ret)
+}
+
+func Map[T any, R any](elms []T, fn func(T) R) []R {
+	ret := make([]R, len(elms))
+	for i, v := range elms {
+		ret[i] = fn(v)
+	}
 	return ret
 }
 
-func Map[T any, R any](elms []T, fn func(T) (R, bool)) []R {
-	var ret []R
+// AppendMap appends the mapped values to dst and returns the extended slice.
+// It does not allocate when dst has enough spare capacity.
+func AppendMap[T any, R any](dst []R, elms []T, fn func(T) R) []R {
+	dst = stdslices.Grow(dst, len(elms))
+	for _, v := range elms {
+		dst = append(dst, fn(v))
+	}
+	return dst
+}
+
+func MapIndexed[T any, R any](elms []T, fn func(int, T) R) []R {
+	ret := make([]R, len(elms))
+	for i, v := range elms {
+		ret[i] = fn(i, v)
+	}
+	return ret
+}
+
+// MapInto writes the mapped values into dst, growing it only if its capacity is too small,
+// and returns dst resliced to len(elms).
+func MapInto[T any, R any](dst []R, elms []T, fn func(T) R) []R {
+	if cap(dst) < len(elms) {
+		dst = make([]R, len(elms))
+	}
+	dst = dst[:len(elms)]
+	for i, v := range elms {
+		dst[i] = fn(v)
+	}
+	return dst
+}
+
+// FilterMap maps elements and keeps only the values fn reports true for.
+// Like Filter, the result is presized for len(elms) values.
+func FilterMap[T any, R any](elms []T, fn func(T) (R, bool)) []R {
+	ret := make([]R, 0, len(elms))
 	for _, v := range elms {
 		nv, Ok := fn(v)
 		if Ok {
 			ret = append(ret, nv)
 		}
 	}
-	return ret
+	return trimPresized(ret)
 }
 
 func Includes[T comparable](elms []T, tgt T) bool {
@@ -50,32 +104,20 @@ func Includes[T comparable](elms []T, tgt T) bool {
 	return false
 }
 
+// RemoveFirst removes the first element equal to tgt, modifying elms.
 func RemoveFirst[T comparable](elms []T, tgt T) []T {
 	for i, v := range elms {
 		if v == tgt {
-			return elms[:i+copy(elms[i:], elms[i+1:])]
+			return DeleteAt(elms, i)
 		}
 	}
 	return elms
 }
 
+// RemoveAll returns a copy of elms without any element equal to tgt. elms is not modified.
+// Use RemoveAllInPlace to reuse the backing array instead.
 func RemoveAll[T comparable](elms []T, tgt T) []T {
-	tmp := elms
-	found := false
-	for {
-		found = false
-		for i, v := range tmp {
-			if v == tgt {
-				tmp = tmp[:i+copy(elms[i:], tmp[i+1:])]
-				found = true
-				break
-			}
-		}
-		if !found {
-			break
-		}
-	}
-	return tmp
+	return Without(elms, tgt)
 }
 
 func Every[T any](elms []T, fn func(T) bool) bool {
@@ -9,46 +9,6 @@ import (
 	"testing"
 )
 
-func TestChunk(t *testing.T) {
-	type args[T any] struct {
-		org       []T
-		chunkSize int
-	}
-
-	type test[M any] struct {
-		name string
-		args args[M]
-		want [][]M
-	}
-
-	tests := []test[int]{
-		{
-			name: "want OK",
-			args: args[int]{
-				org:       []int{1, 2, 3, 4, 5},
-				chunkSize: 2,
-			},
-			want: [][]int{{1, 2}, {3, 4}, {5}},
-		},
-		{
-			name: "want OK2",
-			args: args[int]{
-				org:       []int{1, 2, 3, 4, 5},
-				chunkSize: 1,
-			},
-			want: [][]int{{1}, {2}, {3}, {4}, {5}},
-		},
-	}
-
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			if got := Chunk(tt.args.org, tt.args.chunkSize); !reflect.DeepEqual(got, tt.want) {
-				t.Errorf("Chunk() = %v, want %v", got, tt.want)
-			}
-		})
-	}
-}
-
 func TestFilter(t *testing.T) {
 	type args[T any] struct {
 		src []T
@@ -123,7 +83,33 @@ func TestFilter(t *testing.T) {
 	}
 }
 
-func TestMap(t *testing.T) {
+func TestFilter_Capacity(t *testing.T) {
+	src := []int{1, 2, 3, 4, 5, 6, 7, 8}
+	tests := []struct {
+		name    string
+		fn      func(int) bool
+		wantLen int
+		wantCap int
+	}{
+		{name: "all", fn: func(v int) bool { return true }, wantLen: 8, wantCap: 8},
+		{name: "half", fn: func(v int) bool { return v%2 == 0 }, wantLen: 4, wantCap: 8},
+		{name: "sparse", fn: func(v int) bool { return v == 3 }, wantLen: 1, wantCap: 1},
+		{name: "none", fn: func(v int) bool { return false }, wantLen: 0, wantCap: 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Filter(src, tt.fn)
+			if len(got) != tt.wantLen || cap(got) != tt.wantCap {
+				t.Errorf("Filter() len %d cap %d, want len %d cap %d", len(got), cap(got), tt.wantLen, tt.wantCap)
+			}
+			if tt.wantLen == 0 && got != nil {
+				t.Errorf("Filter() = %#v, want nil", got)
+			}
+		})
+	}
+}
+
+func TestFilterMap(t *testing.T) {
 	type args[T any, M any] struct {
 		src []T
 		fn  func(T) (M, bool)
@@ -179,13 +165,120 @@ func TestMap(t *testing.T) {
 
 	for _, tt := range stringTests {
 		t.Run(tt.name, func(t *testing.T) {
-			if got := Map(tt.args.src, tt.args.fn); !reflect.DeepEqual(got, tt.want) {
-				t.Errorf("Map() = %v, want %v", got, tt.want)
+			if got := FilterMap(tt.args.src, tt.args.fn); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("FilterMap() = %v, want %v", got, tt.want)
 			}
 		})
 	}
 
 	for _, tt := range stringTests2 {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := FilterMap(tt.args.src, tt.args.fn); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("FilterMap() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	for _, tt := range intTests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := FilterMap(tt.args.src, tt.args.fn); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("FilterMap() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFilterIndexed(t *testing.T) {
+	type args[T any] struct {
+		src []T
+		fn  func(int, T) bool
+	}
+
+	type test[M any] struct {
+		name string
+		args args[M]
+		want []M
+	}
+
+	tests := []test[string]{
+		{
+			name: "even index",
+			args: args[string]{
+				[]string{"a", "b", "c", "d"},
+				func(i int, t string) bool {
+					return i%2 == 0
+				},
+			},
+			want: []string{"a", "c"},
+		},
+		{
+			name: "index and value",
+			args: args[string]{
+				[]string{"0", "2", "2", "3"},
+				func(i int, t string) bool {
+					return strconv.Itoa(i) == t
+				},
+			},
+			want: []string{"0", "2", "3"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := FilterIndexed(tt.args.src, tt.args.fn); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("FilterIndexed() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMap(t *testing.T) {
+	type args[T any, M any] struct {
+		src []T
+		fn  func(T) M
+	}
+
+	type test[K any, L any] struct {
+		name string
+		args args[K, L]
+		want []L
+	}
+
+	stringTests := []test[string, string]{
+		{
+			name: "string OK",
+			args: args[string, string]{
+				[]string{"1", "2", "3"},
+				func(t string) string {
+					return fmt.Sprintf("%s:%s", t, t)
+				},
+			},
+			want: []string{"1:1", "2:2", "3:3"},
+		},
+		{
+			name: "empty",
+			args: args[string, string]{
+				[]string{},
+				strings.ToUpper,
+			},
+			want: []string{},
+		},
+	}
+
+	intTests := []test[int, float64]{
+		{
+			name: "int OK",
+			args: args[int, float64]{
+				[]int{1, 2, 3, 4, 5},
+				func(t int) float64 {
+					return float64(t) / 2
+				},
+			},
+			want: []float64{0.5, 1.0, 1.5, 2.0, 2.5},
+		},
+	}
+
+	for _, tt := range stringTests {
 		t.Run(tt.name, func(t *testing.T) {
 			if got := Map(tt.args.src, tt.args.fn); !reflect.DeepEqual(got, tt.want) {
 				t.Errorf("Map() = %v, want %v", got, tt.want)
@@ -202,6 +295,44 @@ func TestMap(t *testing.T) {
 	}
 }
 
+func TestMapIndexed(t *testing.T) {
+	got := MapIndexed([]string{"a", "b", "c"}, func(i int, t string) string {
+		return strconv.Itoa(i) + t
+	})
+	if want := []string{"0a", "1b", "2c"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("MapIndexed() = %v, want %v", got, want)
+	}
+}
+
+func TestMapInto(t *testing.T) {
+	double := func(t int) int { return t * 2 }
+
+	t.Run("reuses dst", func(t *testing.T) {
+		dst := make([]int, 1, 4)
+		got := MapInto(dst, []int{1, 2, 3}, double)
+		if want := []int{2, 4, 6}; !reflect.DeepEqual(got, want) {
+			t.Errorf("MapInto() = %v, want %v", got, want)
+		}
+		if &got[0] != &dst[0] {
+			t.Errorf("MapInto() allocated despite enough capacity")
+		}
+	})
+
+	t.Run("shrinks dst", func(t *testing.T) {
+		got := MapInto([]int{9, 9, 9}, []int{1}, double)
+		if want := []int{2}; !reflect.DeepEqual(got, want) {
+			t.Errorf("MapInto() = %v, want %v", got, want)
+		}
+	})
+
+	t.Run("grows nil dst", func(t *testing.T) {
+		got := MapInto(nil, []int{1, 2}, double)
+		if want := []int{2, 4}; !reflect.DeepEqual(got, want) {
+			t.Errorf("MapInto() = %v, want %v", got, want)
+		}
+	})
+}
+
 func TestIncludes(t *testing.T) {
 	type args[T comparable] struct {
 		src []T
@@ -0,0 +1,159 @@
+package slices
+
+import (
+	"cmp"
+	"container/heap"
+	stdslices "slices"
+)
+
+// Comparator returns a negative number when a sorts before b, a positive number
+// when a sorts after b and zero when they are equal.
+type Comparator[T any] func(a, b T) int
+
+// CompareBy orders values by the key extracted with key.
+func CompareBy[T any, K cmp.Ordered](key func(T) K) Comparator[T] {
+	return func(a, b T) int {
+		return cmp.Compare(key(a), key(b))
+	}
+}
+
+// ThenBy breaks ties of c with next.
+func (c Comparator[T]) ThenBy(next Comparator[T]) Comparator[T] {
+	return func(a, b T) int {
+		if r := c(a, b); r != 0 {
+			return r
+		}
+		return next(a, b)
+	}
+}
+
+func (c Comparator[T]) Reverse() Comparator[T] {
+	return func(a, b T) int {
+		return c(b, a)
+	}
+}
+
+// SortFunc sorts elms in place.
+func SortFunc[T any](elms []T, c Comparator[T]) {
+	stdslices.SortFunc(elms, c)
+}
+
+// SortStableFunc sorts elms in place, keeping the order of equal elements.
+func SortStableFunc[T any](elms []T, c Comparator[T]) {
+	stdslices.SortStableFunc(elms, c)
+}
+
+// SortBy sorts elms in place by the key extracted with key.
+func SortBy[T any, K cmp.Ordered](elms []T, key func(T) K) {
+	stdslices.SortFunc(elms, CompareBy(key))
+}
+
+// SortStableBy sorts elms in place by the key extracted with key, keeping the order of equal elements.
+func SortStableBy[T any, K cmp.Ordered](elms []T, key func(T) K) {
+	stdslices.SortStableFunc(elms, CompareBy(key))
+}
+
+func IsSortedBy[T any, K cmp.Ordered](elms []T, key func(T) K) bool {
+	return stdslices.IsSortedFunc(elms, CompareBy(key))
+}
+
+// BinarySearchBy searches elms sorted by key for target.
+// It returns the position where target is or would be inserted, and whether it was found.
+func BinarySearchBy[T any, K cmp.Ordered](elms []T, target K, key func(T) K) (int, bool) {
+	return stdslices.BinarySearchFunc(elms, target, func(v T, t K) int {
+		return cmp.Compare(key(v), t)
+	})
+}
+
+// TopK returns the k largest elements by c, largest first. elms is not modified.
+// Use cmp.Compare as c for ordered types.
+func TopK[T any](elms []T, k int, c Comparator[T]) []T {
+	return BottomK(elms, k, c.Reverse())
+}
+
+// BottomK returns the k smallest elements by c, smallest first. elms is not modified.
+// Use cmp.Compare as c for ordered types.
+func BottomK[T any](elms []T, k int, c Comparator[T]) []T {
+	if k <= 0 {
+		return []T{}
+	}
+	if k > len(elms) {
+		k = len(elms)
+	}
+
+	// Max-heap of the k smallest elements seen so far.
+	h := &sliceHeap[T]{elms: make([]T, 0, k), less: func(a, b T) bool { return c(a, b) > 0 }}
+	for _, v := range elms {
+		if h.Len() < k {
+			heap.Push(h, v)
+		} else if c(v, h.elms[0]) < 0 {
+			h.elms[0] = v
+			heap.Fix(h, 0)
+		}
+	}
+
+	ret := make([]T, h.Len())
+	for i := len(ret) - 1; i >= 0; i-- {
+		ret[i] = heap.Pop(h).(T)
+	}
+	return ret
+}
+
+// MergeSorted merges slices that are each sorted by c into one sorted slice.
+// Equal elements keep the order of the slices they came from.
+func MergeSorted[T any](c Comparator[T], sorted ...[]T) []T {
+	total := 0
+	for _, s := range sorted {
+		total += len(s)
+	}
+	ret := make([]T, 0, total)
+
+	type cursor struct {
+		src int
+		pos int
+	}
+	h := &sliceHeap[cursor]{less: func(a, b cursor) bool {
+		if r := c(sorted[a.src][a.pos], sorted[b.src][b.pos]); r != 0 {
+			return r < 0
+		}
+		return a.src < b.src
+	}}
+	for i, s := range sorted {
+		if len(s) > 0 {
+			h.elms = append(h.elms, cursor{src: i})
+		}
+	}
+	heap.Init(h)
+
+	for h.Len() > 0 {
+		cur := h.elms[0]
+		ret = append(ret, sorted[cur.src][cur.pos])
+		if cur.pos+1 < len(sorted[cur.src]) {
+			h.elms[0].pos++
+			heap.Fix(h, 0)
+		} else {
+			heap.Pop(h)
+		}
+	}
+	return ret
+}
+
+// sliceHeap implements heap.Interface over a slice with a custom less.
+type sliceHeap[T any] struct {
+	elms []T
+	less func(a, b T) bool
+}
+
+func (h *sliceHeap[T]) Len() int           { return len(h.elms) }
+func (h *sliceHeap[T]) Less(i, j int) bool { return h.less(h.elms[i], h.elms[j]) }
+func (h *sliceHeap[T]) Swap(i, j int)      { h.elms[i], h.elms[j] = h.elms[j], h.elms[i] }
+func (h *sliceHeap[T]) Push(x any)         { h.elms = append(h.elms, x.(T)) }
+
+func (h *sliceHeap[T]) Pop() any {
+	last := len(h.elms) - 1
+	v := h.elms[last]
+	var zero T
+	h.elms[last] = zero
+	h.elms = h.elms[:last]
+	return v
+}
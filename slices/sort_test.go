@@ -0,0 +1,205 @@
+package slices
+
+import (
+	"cmp"
+	"math/rand"
+	"reflect"
+	"sort"
+	"testing"
+)
+
+type sortOriginal struct {
+	id   int
+	name string
+	age  int
+}
+
+func sortSrc() []sortOriginal {
+	return []sortOriginal{
+		{3, "john", 30},
+		{1, "jack", 25},
+		{4, "jade", 30},
+		{2, "bob", 25},
+		{5, "amy", 40},
+	}
+}
+
+func sortIDs(elms []sortOriginal) []int {
+	ret := make([]int, len(elms))
+	for i, v := range elms {
+		ret[i] = v.id
+	}
+	return ret
+}
+
+func TestSortBy(t *testing.T) {
+	src := sortSrc()
+	SortBy(src, func(o sortOriginal) string { return o.name })
+	if want := []int{5, 2, 1, 4, 3}; !reflect.DeepEqual(sortIDs(src), want) {
+		t.Errorf("SortBy() = %v, want %v", sortIDs(src), want)
+	}
+	if !IsSortedBy(src, func(o sortOriginal) string { return o.name }) {
+		t.Errorf("IsSortedBy() = false after SortBy")
+	}
+	if IsSortedBy(src, func(o sortOriginal) int { return o.id }) {
+		t.Errorf("IsSortedBy() = true for unsorted key")
+	}
+}
+
+func TestSortStableBy(t *testing.T) {
+	src := sortSrc()
+	SortStableBy(src, func(o sortOriginal) int { return o.age })
+	if want := []int{1, 2, 3, 4, 5}; !reflect.DeepEqual(sortIDs(src), want) {
+		t.Errorf("SortStableBy() = %v, want %v", sortIDs(src), want)
+	}
+}
+
+func TestComparator(t *testing.T) {
+	byAge := CompareBy(func(o sortOriginal) int { return o.age })
+	byName := CompareBy(func(o sortOriginal) string { return o.name })
+
+	tests := []struct {
+		name string
+		c    Comparator[sortOriginal]
+		want []int
+	}{
+		{name: "then by", c: byAge.ThenBy(byName), want: []int{2, 1, 4, 3, 5}},
+		{name: "reverse then by", c: byAge.Reverse().ThenBy(byName), want: []int{5, 4, 3, 2, 1}},
+		{name: "then by reverse", c: byAge.ThenBy(byName.Reverse()), want: []int{1, 2, 3, 4, 5}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			src := sortSrc()
+			SortFunc(src, tt.c)
+			if !reflect.DeepEqual(sortIDs(src), tt.want) {
+				t.Errorf("SortFunc() = %v, want %v", sortIDs(src), tt.want)
+			}
+
+			src = sortSrc()
+			SortStableFunc(src, tt.c)
+			if !reflect.DeepEqual(sortIDs(src), tt.want) {
+				t.Errorf("SortStableFunc() = %v, want %v", sortIDs(src), tt.want)
+			}
+		})
+	}
+}
+
+func TestBinarySearchBy(t *testing.T) {
+	src := sortSrc()
+	byID := func(o sortOriginal) int { return o.id }
+	SortBy(src, byID)
+
+	tests := []struct {
+		name      string
+		elms      []sortOriginal
+		target    int
+		wantIdx   int
+		wantFound bool
+	}{
+		{name: "found", elms: src, target: 3, wantIdx: 2, wantFound: true},
+		{name: "not found", elms: src, target: 9, wantIdx: 5, wantFound: false},
+		{name: "before first", elms: src, target: 0, wantIdx: 0, wantFound: false},
+		{name: "empty", elms: nil, target: 1, wantIdx: 0, wantFound: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			idx, found := BinarySearchBy(tt.elms, tt.target, byID)
+			if idx != tt.wantIdx || found != tt.wantFound {
+				t.Errorf("BinarySearchBy() = %v, %v, want %v, %v", idx, found, tt.wantIdx, tt.wantFound)
+			}
+		})
+	}
+}
+
+func TestTopKBottomK(t *testing.T) {
+	src := []int{5, 1, 9, 3, 7, 3, 8}
+
+	tests := []struct {
+		name       string
+		k          int
+		wantTop    []int
+		wantBottom []int
+	}{
+		{name: "zero", k: 0, wantTop: []int{}, wantBottom: []int{}},
+		{name: "three", k: 3, wantTop: []int{9, 8, 7}, wantBottom: []int{1, 3, 3}},
+		{name: "more than len", k: 10, wantTop: []int{9, 8, 7, 5, 3, 3, 1}, wantBottom: []int{1, 3, 3, 5, 7, 8, 9}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := TopK(src, tt.k, cmp.Compare[int]); !reflect.DeepEqual(got, tt.wantTop) {
+				t.Errorf("TopK() = %v, want %v", got, tt.wantTop)
+			}
+			if got := BottomK(src, tt.k, cmp.Compare[int]); !reflect.DeepEqual(got, tt.wantBottom) {
+				t.Errorf("BottomK() = %v, want %v", got, tt.wantBottom)
+			}
+		})
+	}
+
+	t.Run("input untouched", func(t *testing.T) {
+		if want := []int{5, 1, 9, 3, 7, 3, 8}; !reflect.DeepEqual(src, want) {
+			t.Errorf("input = %v, want %v", src, want)
+		}
+	})
+
+	t.Run("random", func(t *testing.T) {
+		r := rand.New(rand.NewSource(1))
+		for n := 0; n < 50; n++ {
+			elms := make([]int, n)
+			for i := range elms {
+				elms[i] = r.Intn(20)
+			}
+			sorted := append([]int{}, elms...)
+			sort.Ints(sorted)
+			for k := 0; k <= n; k++ {
+				if got := BottomK(elms, k, cmp.Compare[int]); !reflect.DeepEqual(got, sorted[:k]) {
+					t.Fatalf("BottomK(%v, %d) = %v, want %v", elms, k, got, sorted[:k])
+				}
+			}
+		}
+	})
+}
+
+func TestMergeSorted(t *testing.T) {
+	type tagged struct {
+		v   int
+		src string
+	}
+	byV := CompareBy(func(t tagged) int { return t.v })
+
+	tests := []struct {
+		name   string
+		sorted [][]tagged
+		want   []tagged
+	}{
+		{name: "none", sorted: nil, want: []tagged{}},
+		{name: "empty slices", sorted: [][]tagged{{}, nil}, want: []tagged{}},
+		{
+			name: "merged",
+			sorted: [][]tagged{
+				{{1, "a"}, {4, "a"}, {7, "a"}},
+				{},
+				{{2, "c"}, {4, "c"}},
+				{{0, "d"}, {9, "d"}},
+			},
+			want: []tagged{{0, "d"}, {1, "a"}, {2, "c"}, {4, "a"}, {4, "c"}, {7, "a"}, {9, "d"}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := MergeSorted(byV, tt.sorted...); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("MergeSorted() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	t.Run("strings", func(t *testing.T) {
+		got := MergeSorted(cmp.Compare[string], []string{"a", "c"}, []string{"b", "d"})
+		if want := []string{"a", "b", "c", "d"}; !reflect.DeepEqual(got, want) {
+			t.Errorf("MergeSorted() = %v, want %v", got, want)
+		}
+	})
+}
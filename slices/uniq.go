@@ -0,0 +1,79 @@
+package slices
+
+import (
+	"cmp"
+	stdslices "slices"
+)
+
+// Uniq returns the distinct elements of elms in first-occurrence order.
+// O(n) time and O(n) extra space for a map of seen elements.
+func Uniq[T comparable](elms []T) []T {
+	return UniqBy(elms, func(v T) T { return v })
+}
+
+// UniqBy returns the first element for each distinct key, in input order.
+// O(n) time and O(n) extra space for a map of seen keys.
+func UniqBy[T any, K comparable](elms []T, key func(T) K) []T {
+	seen := make(map[K]struct{}, len(elms))
+	ret := make([]T, 0, len(elms))
+	for _, v := range elms {
+		k := key(v)
+		if _, ok := seen[k]; ok {
+			continue
+		}
+		seen[k] = struct{}{}
+		ret = append(ret, v)
+	}
+	return ret
+}
+
+// UniqInPlace is Uniq reusing the backing array of elms instead of allocating a result.
+// The tail past the returned length is zeroed. O(n) time, O(n) extra space for the seen map.
+func UniqInPlace[T comparable](elms []T) []T {
+	seen := make(map[T]struct{}, len(elms))
+	return RemoveFunc(elms, func(v T) bool {
+		if _, ok := seen[v]; ok {
+			return true
+		}
+		seen[v] = struct{}{}
+		return false
+	})
+}
+
+// UniqSorted returns the distinct elements of elms in ascending order.
+// It sorts a copy instead of using a map: O(n log n) time, no extra space beyond the result.
+func UniqSorted[T cmp.Ordered](elms []T) []T {
+	ret := append([]T(nil), elms...)
+	stdslices.Sort(ret)
+	return stdslices.Compact(ret)
+}
+
+// Compact returns a copy of elms with runs of equal consecutive elements replaced by one.
+// O(n) time.
+func Compact[T comparable](elms []T) []T {
+	ret := make([]T, 0, len(elms))
+	for i, v := range elms {
+		if i > 0 && v == elms[i-1] {
+			continue
+		}
+		ret = append(ret, v)
+	}
+	return ret
+}
+
+// Duplicates returns each element that appears more than once, in first-occurrence order.
+// O(n) time and O(n) extra space.
+func Duplicates[T comparable](elms []T) []T {
+	counts := make(map[T]int, len(elms))
+	for _, v := range elms {
+		counts[v]++
+	}
+	ret := make([]T, 0)
+	for _, v := range elms {
+		if counts[v] > 1 {
+			ret = append(ret, v)
+			counts[v] = 0
+		}
+	}
+	return ret
+}
@@ -0,0 +1,146 @@
+package slices
+
+import (
+	"math/rand"
+	"reflect"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func TestUniq(t *testing.T) {
+	tests := []struct {
+		name string
+		src  []int
+		want []int
+	}{
+		{name: "empty", src: []int{}, want: []int{}},
+		{name: "no duplicates", src: []int{3, 1, 2}, want: []int{3, 1, 2}},
+		{name: "first occurrence order", src: []int{3, 1, 3, 2, 1, 3}, want: []int{3, 1, 2}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			orig := append([]int{}, tt.src...)
+			if got := Uniq(tt.src); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Uniq() = %v, want %v", got, tt.want)
+			}
+			if !reflect.DeepEqual(tt.src, orig) {
+				t.Errorf("Uniq() modified input to %v", tt.src)
+			}
+
+			got := UniqInPlace(tt.src)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("UniqInPlace() = %v, want %v", got, tt.want)
+			}
+			if len(got) > 0 && &got[0] != &tt.src[0] {
+				t.Errorf("UniqInPlace() allocated a new result")
+			}
+			for _, v := range tt.src[len(got):] {
+				if v != 0 {
+					t.Errorf("UniqInPlace() left tail %v", tt.src[len(got):])
+					break
+				}
+			}
+		})
+	}
+}
+
+func TestUniqBy(t *testing.T) {
+	src := []string{"apple", "Avocado", "banana", "apricot", "Blueberry"}
+	got := UniqBy(src, func(s string) string { return strings.ToLower(s[:1]) })
+	if want := []string{"apple", "banana"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("UniqBy() = %v, want %v", got, want)
+	}
+}
+
+func TestUniqSorted(t *testing.T) {
+	tests := []struct {
+		name string
+		src  []string
+		want []string
+	}{
+		{name: "empty", src: []string{}, want: nil},
+		{name: "sorted", src: []string{"c", "a", "b", "a", "c"}, want: []string{"a", "b", "c"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := UniqSorted(tt.src); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("UniqSorted() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCompact(t *testing.T) {
+	tests := []struct {
+		name string
+		src  []int
+		want []int
+	}{
+		{name: "empty", src: []int{}, want: []int{}},
+		{name: "runs", src: []int{1, 1, 2, 2, 2, 1, 3, 3}, want: []int{1, 2, 1, 3}},
+		{name: "no runs", src: []int{1, 2, 1}, want: []int{1, 2, 1}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Compact(tt.src); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Compact() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDuplicates(t *testing.T) {
+	tests := []struct {
+		name string
+		src  []string
+		want []string
+	}{
+		{name: "empty", src: []string{}, want: []string{}},
+		{name: "none", src: []string{"a", "b"}, want: []string{}},
+		{name: "first occurrence order", src: []string{"b", "a", "c", "a", "b", "b"}, want: []string{"b", "a"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Duplicates(tt.src); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Duplicates() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func BenchmarkUniq(b *testing.B) {
+	r := rand.New(rand.NewSource(1))
+	for _, n := range []int{10, 1000, 100000} {
+		// Roughly half of the elements are duplicates.
+		elms := make([]int, n)
+		for i := range elms {
+			elms[i] = r.Intn(n/2 + 1)
+		}
+
+		b.Run("map/n="+strconv.Itoa(n), func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				Uniq(elms)
+			}
+		})
+		b.Run("sort/n="+strconv.Itoa(n), func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				UniqSorted(elms)
+			}
+		})
+		b.Run("in-place/n="+strconv.Itoa(n), func(b *testing.B) {
+			b.ReportAllocs()
+			buf := make([]int, n)
+			for i := 0; i < b.N; i++ {
+				copy(buf, elms)
+				UniqInPlace(buf)
+			}
+		})
+	}
+}
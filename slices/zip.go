@@ -0,0 +1,129 @@
+package slices
+
+import (
+	"errors"
+	"fmt"
+)
+
+type Pair[A any, B any] struct {
+	First  A
+	Second B
+}
+
+// Zip pairs elements of a and b by index, stopping at the shorter slice.
+func Zip[A any, B any](a []A, b []B) []Pair[A, B] {
+	return ZipWith(a, b, func(x A, y B) Pair[A, B] {
+		return Pair[A, B]{x, y}
+	})
+}
+
+// ZipWith combines elements of a and b by index with fn, stopping at the shorter slice.
+func ZipWith[A any, B any, R any](a []A, b []B, fn func(A, B) R) []R {
+	n := min(len(a), len(b))
+	ret := make([]R, n)
+	for i := 0; i < n; i++ {
+		ret[i] = fn(a[i], b[i])
+	}
+	return ret
+}
+
+// ZipLongest pairs elements of a and b by index up to the longer slice,
+// using fillA and fillB for the missing elements of the shorter one.
+func ZipLongest[A any, B any](a []A, b []B, fillA A, fillB B) []Pair[A, B] {
+	n := max(len(a), len(b))
+	ret := make([]Pair[A, B], n)
+	for i := 0; i < n; i++ {
+		ret[i] = Pair[A, B]{fillA, fillB}
+		if i < len(a) {
+			ret[i].First = a[i]
+		}
+		if i < len(b) {
+			ret[i].Second = b[i]
+		}
+	}
+	return ret
+}
+
+func Unzip[A any, B any](pairs []Pair[A, B]) ([]A, []B) {
+	as := make([]A, len(pairs))
+	bs := make([]B, len(pairs))
+	for i, p := range pairs {
+		as[i], bs[i] = p.First, p.Second
+	}
+	return as, bs
+}
+
+// CartesianProduct returns every combination taking one element from each slice,
+// varying the last slice fastest. It returns nil when no slices or an empty slice are given.
+func CartesianProduct[T any](elms ...[]T) [][]T {
+	if len(elms) == 0 {
+		return nil
+	}
+	total := 1
+	for _, s := range elms {
+		if len(s) == 0 {
+			return nil
+		}
+		total *= len(s)
+	}
+
+	ret := make([][]T, total)
+	for i := range ret {
+		row := make([]T, len(elms))
+		rest := i
+		for j := len(elms) - 1; j >= 0; j-- {
+			row[j] = elms[j][rest%len(elms[j])]
+			rest /= len(elms[j])
+		}
+		ret[i] = row
+	}
+	return ret
+}
+
+// RaggedPolicy decides how Transpose handles rows of different lengths.
+type RaggedPolicy int
+
+const (
+	// RaggedError makes Transpose return an error wrapping ErrRagged.
+	RaggedError RaggedPolicy = iota
+	// RaggedTruncate drops columns that some row is too short for.
+	RaggedTruncate
+	// RaggedSkip builds each column from the rows long enough to have it.
+	RaggedSkip
+)
+
+var ErrRagged = errors.New("ragged rows")
+
+// Transpose swaps rows and columns of m. Empty m returns nil.
+func Transpose[T any](m [][]T, policy RaggedPolicy) ([][]T, error) {
+	if len(m) == 0 {
+		return nil, nil
+	}
+
+	shortest, longest := len(m[0]), len(m[0])
+	for _, row := range m {
+		shortest = min(shortest, len(row))
+		longest = max(longest, len(row))
+	}
+
+	cols := longest
+	switch {
+	case shortest == longest:
+	case policy == RaggedError:
+		return nil, fmt.Errorf("%w: lengths between %d and %d", ErrRagged, shortest, longest)
+	case policy == RaggedTruncate:
+		cols = shortest
+	}
+
+	ret := make([][]T, cols)
+	for j := range ret {
+		col := make([]T, 0, len(m))
+		for _, row := range m {
+			if j < len(row) {
+				col = append(col, row[j])
+			}
+		}
+		ret[j] = col
+	}
+	return ret, nil
+}
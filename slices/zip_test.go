@@ -0,0 +1,129 @@
+package slices
+
+import (
+	"errors"
+	"reflect"
+	"strconv"
+	"testing"
+)
+
+func TestZip(t *testing.T) {
+	type test[A any, B any] struct {
+		name string
+		a    []A
+		b    []B
+		want []Pair[A, B]
+	}
+
+	tests := []test[string, int]{
+		{name: "empty", a: nil, b: []int{1}, want: []Pair[string, int]{}},
+		{name: "same length", a: []string{"a", "b"}, b: []int{1, 2}, want: []Pair[string, int]{{"a", 1}, {"b", 2}}},
+		{name: "shorter a", a: []string{"a"}, b: []int{1, 2}, want: []Pair[string, int]{{"a", 1}}},
+		{name: "shorter b", a: []string{"a", "b", "c"}, b: []int{1, 2}, want: []Pair[string, int]{{"a", 1}, {"b", 2}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Zip(tt.a, tt.b)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Zip() = %v, want %v", got, tt.want)
+			}
+
+			as, bs := Unzip(got)
+			n := len(tt.want)
+			if !reflect.DeepEqual(as, append([]string{}, tt.a[:n]...)) || !reflect.DeepEqual(bs, append([]int{}, tt.b[:n]...)) {
+				t.Errorf("Unzip() = %v, %v", as, bs)
+			}
+		})
+	}
+}
+
+func TestZipWith(t *testing.T) {
+	got := ZipWith([]string{"a", "b", "c"}, []int{1, 2}, func(s string, i int) string {
+		return s + strconv.Itoa(i)
+	})
+	if want := []string{"a1", "b2"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("ZipWith() = %v, want %v", got, want)
+	}
+}
+
+func TestZipLongest(t *testing.T) {
+	type test[A any, B any] struct {
+		name string
+		a    []A
+		b    []B
+		want []Pair[A, B]
+	}
+
+	tests := []test[string, int]{
+		{name: "empty", a: nil, b: nil, want: []Pair[string, int]{}},
+		{name: "fill a", a: []string{"a"}, b: []int{1, 2}, want: []Pair[string, int]{{"a", 1}, {"-", 2}}},
+		{name: "fill b", a: []string{"a", "b", "c"}, b: []int{1}, want: []Pair[string, int]{{"a", 1}, {"b", -1}, {"c", -1}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ZipLongest(tt.a, tt.b, "-", -1); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ZipLongest() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCartesianProduct(t *testing.T) {
+	tests := []struct {
+		name string
+		elms [][]int
+		want [][]int
+	}{
+		{name: "none", elms: nil, want: nil},
+		{name: "with empty", elms: [][]int{{1, 2}, {}}, want: nil},
+		{name: "single", elms: [][]int{{1, 2}}, want: [][]int{{1}, {2}}},
+		{
+			name: "three",
+			elms: [][]int{{1, 2}, {3}, {4, 5}},
+			want: [][]int{{1, 3, 4}, {1, 3, 5}, {2, 3, 4}, {2, 3, 5}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := CartesianProduct(tt.elms...); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("CartesianProduct() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTranspose(t *testing.T) {
+	ragged := [][]int{{1, 2, 3}, {4}, {5, 6}}
+
+	tests := []struct {
+		name    string
+		m       [][]int
+		policy  RaggedPolicy
+		want    [][]int
+		wantErr error
+	}{
+		{name: "empty", m: nil, policy: RaggedError, want: nil},
+		{name: "square", m: [][]int{{1, 2}, {3, 4}}, policy: RaggedError, want: [][]int{{1, 3}, {2, 4}}},
+		{name: "rectangle", m: [][]int{{1, 2, 3}, {4, 5, 6}}, policy: RaggedError, want: [][]int{{1, 4}, {2, 5}, {3, 6}}},
+		{name: "chunked", m: Chunk([]int{1, 2, 3, 4, 5, 6}, 3), policy: RaggedError, want: [][]int{{1, 4}, {2, 5}, {3, 6}}},
+		{name: "empty rows", m: [][]int{{}, {}}, policy: RaggedError, want: [][]int{}},
+		{name: "ragged error", m: ragged, policy: RaggedError, wantErr: ErrRagged},
+		{name: "ragged truncate", m: ragged, policy: RaggedTruncate, want: [][]int{{1, 4, 5}}},
+		{name: "ragged skip", m: ragged, policy: RaggedSkip, want: [][]int{{1, 4, 5}, {2, 6}, {3}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Transpose(tt.m, tt.policy)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("Transpose() error = %v, want %v", err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Transpose() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}